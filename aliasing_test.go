@@ -0,0 +1,158 @@
+package slice_test
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+func identity(number int) int {
+	return number
+}
+
+func isOdd(number int) bool {
+	return number%2 == 1
+}
+
+func isEven(number int) bool {
+	return number%2 == 0
+}
+
+func compareInts(left int, right int) int {
+	return left - right
+}
+
+// aliasingCases holds every function that could hand back memory from its input slice, each wrapped to return
+// one such slice. Functions whose results are built only from values they create, such as Zip, Range or the
+// accumulators of ChunkWhile and WindowReduce, cannot share the input's backing array and are left out.
+var aliasingCases = map[string]func([]int) []int{
+	"ChunkBy":           func(numbers []int) []int { return slice.ChunkBy(numbers, isOdd)[0] },
+	"ChunkEvery":        func(numbers []int) []int { return slice.ChunkEvery(numbers, 10, 10, []int{})[0] },
+	"ChunkEveryDiscard": func(numbers []int) []int { return slice.ChunkEveryDiscard(numbers, 3, 3)[0] },
+	"Concat":            func(numbers []int) []int { return slice.Concat(numbers, []int{9}) },
+	"ConcatAll":         func(numbers []int) []int { return slice.ConcatAll(numbers) },
+	"ConcatAll several": func(numbers []int) []int { return slice.ConcatAll(numbers, []int{}, numbers) },
+	"CumulativeProduct": func(numbers []int) []int { return slice.CumulativeProduct(numbers) },
+	"CumulativeSum":     func(numbers []int) []int { return slice.CumulativeSum(numbers) },
+	"Cycle":             func(numbers []int) []int { return slice.Cycle(numbers, 5) },
+	"Difference":        func(numbers []int) []int { return slice.Difference(numbers, []int{}) },
+	"DifferenceBy":      func(numbers []int) []int { return slice.DifferenceBy(numbers, []int{}, identity) },
+	"Drop":              func(numbers []int) []int { return slice.Drop(numbers, 1) },
+	"DropEvery":         func(numbers []int) []int { return slice.DropEvery(numbers, 0) },
+	"DropLast":          func(numbers []int) []int { return slice.DropLast(numbers, 1) },
+	"DropWhile":         func(numbers []int) []int { return slice.DropWhile(numbers, isEven) },
+	"Filter":            func(numbers []int) []int { return slice.Filter(numbers, isOdd) },
+	"FilterCtx": func(numbers []int) []int {
+		filtered, _ := slice.FilterCtx(context.Background(), numbers, isOdd)
+		return filtered
+	},
+	"FilterErr": func(numbers []int) []int {
+		filtered, _ := slice.FilterErr(numbers, func(int) (bool, error) { return true, nil })
+		return filtered
+	},
+	"FilterWithIndex": func(numbers []int) []int {
+		return slice.FilterWithIndex(numbers, func(int, int) bool { return true })
+	},
+	"FlatMap": func(numbers []int) []int { return slice.FlatMap(numbers, func(int) []int { return numbers }) },
+	"FlatMapErr": func(numbers []int) []int {
+		flattened, _ := slice.FlatMapErr(numbers, func(int) ([]int, error) { return numbers, nil })
+		return flattened
+	},
+	"GroupBy": func(numbers []int) []int { return slice.GroupBy(numbers, isOdd)[true] },
+	"GroupByErr": func(numbers []int) []int {
+		groups, _ := slice.GroupByErr(numbers, func(number int) (bool, error) { return isOdd(number), nil })
+		return groups[true]
+	},
+	"Intersection":   func(numbers []int) []int { return slice.Intersection(numbers, numbers) },
+	"IntersectionBy": func(numbers []int) []int { return slice.IntersectionBy(numbers, numbers, identity) },
+	"Map":            func(numbers []int) []int { return slice.Map(numbers, identity) },
+	"MapCtx": func(numbers []int) []int {
+		mapped, _ := slice.MapCtx(context.Background(), numbers, identity)
+		return mapped
+	},
+	"MapErr": func(numbers []int) []int {
+		mapped, _ := slice.MapErr(numbers, func(number int) (int, error) { return number, nil })
+		return mapped
+	},
+	"MapWithIndex": func(numbers []int) []int {
+		return slice.MapWithIndex(numbers, func(number int, _ int) int { return number })
+	},
+	"MovingMax":      func(numbers []int) []int { return slice.MovingMax(numbers, 1) },
+	"MovingMin":      func(numbers []int) []int { return slice.MovingMin(numbers, 1) },
+	"MovingSum":      func(numbers []int) []int { return slice.MovingSum(numbers, 1) },
+	"ParallelFilter": func(numbers []int) []int { return slice.ParallelFilter(numbers, isOdd, 2) },
+	"ParallelMap":    func(numbers []int) []int { return slice.ParallelMap(numbers, identity, 2) },
+	"Reject":         func(numbers []int) []int { return slice.Reject(numbers, isEven) },
+	"RejectErr": func(numbers []int) []int {
+		kept, _ := slice.RejectErr(numbers, func(int) (bool, error) { return false, nil })
+		return kept
+	},
+	"RejectWithIndex": func(numbers []int) []int {
+		return slice.RejectWithIndex(numbers, func(int, int) bool { return false })
+	},
+	"Reverse":    func(numbers []int) []int { return slice.Reverse(numbers) },
+	"RunningMax": func(numbers []int) []int { return slice.RunningMax(numbers) },
+	"RunningMin": func(numbers []int) []int { return slice.RunningMin(numbers) },
+	"Scan": func(numbers []int) []int {
+		return slice.Scan(numbers, func(number int, _ int) int { return number }, 0)
+	},
+	"Shuffle":     func(numbers []int) []int { return slice.Shuffle(numbers, 42) },
+	"ShuffleFrom": func(numbers []int) []int { return slice.ShuffleFrom(numbers, rand.New(rand.NewSource(42))) },
+	"Slice":       func(numbers []int) []int { return slice.Slice(numbers, 0, 10) },
+	"Sort":        func(numbers []int) []int { return slice.Sort(numbers, slice.Asc) },
+	"SortBy":      func(numbers []int) []int { return slice.SortBy(numbers, identity, slice.Desc) },
+	"SortByErr": func(numbers []int) []int {
+		sorted, _ := slice.SortByErr(numbers, func(number int) (int, error) { return number, nil }, slice.Asc)
+		return sorted
+	},
+	"SortByKeys":                func(numbers []int) []int { return slice.SortByKeys(numbers, slice.By(identity, slice.Asc)) },
+	"SortWith":                  func(numbers []int) []int { return slice.SortWith(numbers, compareInts) },
+	"SortedDifference":          func(numbers []int) []int { return slice.SortedDifference(numbers, []int{}, slice.Asc) },
+	"SortedIntersection":        func(numbers []int) []int { return slice.SortedIntersection(numbers, numbers, slice.Asc) },
+	"SortedSymmetricDifference": func(numbers []int) []int { return slice.SortedSymmetricDifference(numbers, []int{}, slice.Asc) },
+	"SortedUnion":               func(numbers []int) []int { return slice.SortedUnion(numbers, numbers, slice.Asc) },
+	"SplitWhile": func(numbers []int) []int {
+		left, _ := slice.SplitWhile(numbers, isOdd)
+		return left
+	},
+	"SplitWith": func(numbers []int) []int {
+		left, _ := slice.SplitWith(numbers, isOdd)
+		return left
+	},
+	"SymmetricDifference":   func(numbers []int) []int { return slice.SymmetricDifference(numbers, []int{}) },
+	"SymmetricDifferenceBy": func(numbers []int) []int { return slice.SymmetricDifferenceBy(numbers, []int{}, identity) },
+	"Take":                  func(numbers []int) []int { return slice.Take(numbers, 10) },
+	"TakeEvery":             func(numbers []int) []int { return slice.TakeEvery(numbers, 1) },
+	"TakeLast":              func(numbers []int) []int { return slice.TakeLast(numbers, 10) },
+	"TakeWhile":             func(numbers []int) []int { return slice.TakeWhile(numbers, isOdd) },
+	"Union":                 func(numbers []int) []int { return slice.Union(numbers, numbers) },
+	"UnionBy":               func(numbers []int) []int { return slice.UnionBy(numbers, numbers, identity) },
+	"Uniq":                  func(numbers []int) []int { return slice.Uniq(numbers) },
+	"UniqBy":                func(numbers []int) []int { return slice.UniqBy(numbers, identity) },
+	"UniqWith":              func(numbers []int) []int { return slice.UniqWith(numbers, compareInts) },
+	"ZipWith": func(numbers []int) []int {
+		return slice.ZipWith(numbers, numbers, func(left int, _ int) int { return left })
+	},
+}
+
+// TestNoAliasing checks the package's aliasing contract: overwriting or appending to a returned slice never
+// changes the input, even when the input has spare capacity for append to write into.
+func TestNoAliasing(t *testing.T) {
+	for name, fun := range aliasingCases {
+		numbers := make([]int, 3, 10)
+		copy(numbers, []int{1, 3, 5})
+
+		got := fun(numbers)
+		for index := range got {
+			got[index] = -1
+		}
+		_ = append(got, -1, -1, -1, -1, -1, -1, -1)
+
+		if !reflect.DeepEqual(numbers[:cap(numbers)], []int{1, 3, 5, 0, 0, 0, 0, 0, 0, 0}) {
+			t.Errorf("%s aliases its input: %v", name, numbers[:cap(numbers)])
+		}
+	}
+}
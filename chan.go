@@ -0,0 +1,166 @@
+package slice
+
+import (
+	"context"
+	"time"
+)
+
+// BatchChan groups elements received from in into batches of up to size elements.
+//
+// A batch is sent once it holds size elements, once timeout has passed since its first element
+// arrived, or when in is closed. A timeout of zero or less disables the timer. The returned channel
+// is closed when in is closed or ctx is done; a partial batch is dropped if ctx is done first.
+func BatchChan[Element any](ctx context.Context, in <-chan Element, size uint, timeout time.Duration) <-chan []Element {
+	if size == 0 {
+		panic("unexpected value for size parameter")
+	}
+	out := make(chan []Element)
+	go func() {
+		defer close(out)
+		batch := make([]Element, 0, size)
+		var timer *time.Timer
+		var expired <-chan time.Time
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, expired = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			if !send(ctx, out, batch) {
+				return false
+			}
+			batch = make([]Element, 0, size)
+			return true
+		}
+		for {
+			select {
+			case element, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, element)
+				if uint(len(batch)) == size {
+					if !flush() {
+						return
+					}
+				} else if len(batch) == 1 && timeout > 0 {
+					timer = time.NewTimer(timeout)
+					expired = timer.C
+				}
+			case <-expired:
+				timer, expired = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FilterChan sends the elements received from in where fun returns true.
+//
+// The returned channel is closed when in is closed or ctx is done.
+func FilterChan[Element any](ctx context.Context, in <-chan Element, fun func(Element) bool) <-chan Element {
+	out := make(chan Element)
+	go func() {
+		defer close(out)
+		receiveEach(ctx, in, func(element Element) bool {
+			if !fun(element) {
+				return true
+			}
+			return send(ctx, out, element)
+		})
+	}()
+	return out
+}
+
+// FromChan receives every element from the channel until it is closed and returns them as a slice.
+func FromChan[Element any](elements <-chan Element) []Element {
+	return ReduceChan(elements, func(element Element, accumulator []Element) []Element {
+		return append(accumulator, element)
+	}, make([]Element, 0))
+}
+
+// MapChan invokes fun on each element received from in and sends the result.
+//
+// The returned channel is closed when in is closed or ctx is done.
+func MapChan[Element any, ReturnElement any](ctx context.Context, in <-chan Element, fun func(Element) ReturnElement) <-chan ReturnElement {
+	out := make(chan ReturnElement)
+	go func() {
+		defer close(out)
+		receiveEach(ctx, in, func(element Element) bool {
+			return send(ctx, out, fun(element))
+		})
+	}()
+	return out
+}
+
+// ReduceChan invokes fun on each element received from the channel with the accumulator until it is closed.
+func ReduceChan[Element any, Accumulator any](elements <-chan Element, fun func(Element, Accumulator) Accumulator, accumulator Accumulator) Accumulator {
+	return ReduceWhileChan(elements, func(element Element, accumulator Accumulator) (Reduction, Accumulator) {
+		return Cont, fun(element, accumulator)
+	}, accumulator)
+}
+
+// ReduceWhileChan invokes fun on each element received from the channel with the accumulator until Halt is
+// returned or the channel is closed.
+//
+// Returning Halt stops reading from the channel, leaving any remaining elements for other receivers.
+func ReduceWhileChan[Element any, Accumulator any](elements <-chan Element, fun func(Element, Accumulator) (Reduction, Accumulator), accumulator Accumulator) Accumulator {
+	reduction := Cont
+	for element := range elements {
+		reduction, accumulator = fun(element, accumulator)
+		if reduction == Halt {
+			return accumulator
+		}
+	}
+	return accumulator
+}
+
+// ToChan sends each element in the slice on the returned channel, closing it after the last element
+// or when ctx is done.
+func ToChan[Element any](ctx context.Context, elements []Element) <-chan Element {
+	out := make(chan Element)
+	go func() {
+		defer close(out)
+		for _, element := range elements {
+			if !send(ctx, out, element) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// receiveEach invokes fun on each element received from in until in is closed, ctx is done or fun returns false.
+func receiveEach[Element any](ctx context.Context, in <-chan Element, fun func(Element) bool) {
+	for {
+		select {
+		case element, ok := <-in:
+			if !ok || !fun(element) {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// send sends the element on out, returning false if ctx is done first.
+func send[Element any](ctx context.Context, out chan<- Element, element Element) bool {
+	select {
+	case out <- element:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,104 @@
+package slice_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nwjlyons/slice"
+)
+
+// fill returns a closed buffered channel holding the elements.
+func fill[Element any](elements ...Element) chan Element {
+	ch := make(chan Element, len(elements))
+	for _, element := range elements {
+		ch <- element
+	}
+	close(ch)
+	return ch
+}
+
+func TestBatchChan(t *testing.T) {
+	ctx := context.Background()
+	got := slice.FromChan(slice.BatchChan(ctx, fill(1, 2, 3, 4, 5), 2, 0))
+	assertEqual(t, got, [][]int{{1, 2}, {3, 4}, {5}})
+	assertEqual(t, slice.FromChan(slice.BatchChan(ctx, fill[int](), 2, 0)), [][]int{})
+
+	in := make(chan int)
+	batches := slice.BatchChan(ctx, in, 10, 50*time.Millisecond)
+	in <- 1
+	in <- 2
+	assertEqual(t, <-batches, []int{1, 2})
+	in <- 3
+	close(in)
+	assertEqual(t, <-batches, []int{3})
+	_, open := <-batches
+	assertEqual(t, open, false)
+}
+
+func TestBatchChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	batches := slice.BatchChan(ctx, in, 10, 0)
+	in <- 1
+	cancel()
+	assertEqual(t, slice.FromChan(batches), [][]int{})
+}
+
+func TestFilterChan(t *testing.T) {
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	got := slice.FromChan(slice.FilterChan(context.Background(), fill(1, 2, 3, 4), isEven))
+	assertEqual(t, got, []int{2, 4})
+}
+
+func TestFromChan(t *testing.T) {
+	assertEqual(t, slice.FromChan(fill("Mercury", "Venus")), []string{"Mercury", "Venus"})
+	assertEqual(t, slice.FromChan(fill[string]()), []string{})
+}
+
+func TestMapChan(t *testing.T) {
+	got := slice.FromChan(slice.MapChan(context.Background(), fill(1, 2, 3), strconv.Itoa))
+	assertEqual(t, got, []string{"1", "2", "3"})
+}
+
+func TestMapChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := slice.MapChan(ctx, in, strconv.Itoa)
+	cancel()
+	_, open := <-out
+	assertEqual(t, open, false)
+}
+
+func TestReduceChan(t *testing.T) {
+	got := slice.ReduceChan(fill(1, 2, 3), func(number int, total int) int {
+		return total + number
+	}, 0)
+	assertEqual(t, got, 6)
+}
+
+func TestReduceWhileChan(t *testing.T) {
+	numbers := fill(1, 2, 3, 4, 5)
+	got := slice.ReduceWhileChan(numbers, func(number int, total int) (slice.Reduction, int) {
+		if number == 3 {
+			return slice.Halt, total
+		}
+		return slice.Cont, total + number
+	}, 0)
+	assertEqual(t, got, 3)
+	assertEqual(t, slice.FromChan(numbers), []int{4, 5})
+}
+
+func TestToChan(t *testing.T) {
+	assertEqual(t, slice.FromChan(slice.ToChan(context.Background(), []int{1, 2, 3})), []int{1, 2, 3})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numbers := slice.ToChan(ctx, []int{1, 2, 3})
+	assertEqual(t, <-numbers, 1)
+	cancel()
+	for range numbers {
+	}
+}
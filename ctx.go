@@ -0,0 +1,66 @@
+package slice
+
+import "context"
+
+// EachCtx invokes fun on each element in the slice until ctx is done.
+//
+// If ctx is done it returns ctx.Err(), and fun has been invoked on a prefix of the slice.
+func EachCtx[Element any](ctx context.Context, elements []Element, fun func(Element)) error {
+	_, err := ReduceCtx(ctx, elements, func(element Element, accumulator interface{}) interface{} {
+		fun(element)
+		return accumulator
+	}, nil)
+	return err
+}
+
+// FilterCtx returns elements where fun returns true until ctx is done.
+//
+// If ctx is done it returns the elements filtered so far along with ctx.Err().
+func FilterCtx[Element any](ctx context.Context, elements []Element, fun func(Element) bool) ([]Element, error) {
+	return ReduceCtx(ctx, elements, func(element Element, accumulator []Element) []Element {
+		if fun(element) {
+			return append(accumulator, element)
+		}
+		return accumulator
+	}, make([]Element, 0))
+}
+
+// MapCtx invokes fun on each element in the slice until ctx is done.
+//
+// If ctx is done it returns the elements mapped so far along with ctx.Err().
+func MapCtx[Element any, ReturnElement any](ctx context.Context, elements []Element, fun func(Element) ReturnElement) ([]ReturnElement, error) {
+	return ReduceCtx(ctx, elements, func(element Element, accumulator []ReturnElement) []ReturnElement {
+		return append(accumulator, fun(element))
+	}, make([]ReturnElement, 0, len(elements)))
+}
+
+// ReduceCtx invokes fun on each element in the slice with the accumulator until ctx is done.
+//
+// If ctx is done it returns the partial accumulator along with ctx.Err().
+func ReduceCtx[Element any, Accumulator any](ctx context.Context, elements []Element, fun func(Element, Accumulator) Accumulator, accumulator Accumulator) (Accumulator, error) {
+	return ReduceWhileCtx(ctx, elements, func(element Element, accumulator Accumulator) (Reduction, Accumulator) {
+		return Cont, fun(element, accumulator)
+	}, accumulator)
+}
+
+// ReduceWhileCtx invokes fun on each element in the slice with the accumulator until Halt is returned
+// or ctx is done.
+//
+// ctx is checked before every element, so fun is not invoked again once ctx is done. If ctx is done
+// it returns the partial accumulator along with ctx.Err().
+func ReduceWhileCtx[Element any, Accumulator any](ctx context.Context, elements []Element, fun func(Element, Accumulator) (Reduction, Accumulator), accumulator Accumulator) (Accumulator, error) {
+	done := ctx.Done()
+	reduction := Cont
+	for _, element := range elements {
+		select {
+		case <-done:
+			return accumulator, ctx.Err()
+		default:
+		}
+		reduction, accumulator = fun(element, accumulator)
+		if reduction == Halt {
+			return accumulator, nil
+		}
+	}
+	return accumulator, nil
+}
@@ -0,0 +1,68 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+func TestEachCtx(t *testing.T) {
+	total := 0
+	err := slice.EachCtx(context.Background(), []int{1, 2, 3}, func(number int) {
+		total += number
+	})
+	assertEqual(t, err, nil)
+	assertEqual(t, total, 6)
+}
+
+func TestFilterCtx(t *testing.T) {
+	got, err := slice.FilterCtx(context.Background(), []int{1, 2, 3, 4}, func(number int) bool {
+		return number%2 == 0
+	})
+	assertEqual(t, err, nil)
+	assertEqual(t, got, []int{2, 4})
+}
+
+func TestMapCtx(t *testing.T) {
+	got, err := slice.MapCtx(context.Background(), []int{1, 2, 3}, strconv.Itoa)
+	assertEqual(t, err, nil)
+	assertEqual(t, got, []string{"1", "2", "3"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got, err = slice.MapCtx(ctx, []int{1, 2, 3}, strconv.Itoa)
+	assertEqual(t, errors.Is(err, context.Canceled), true)
+	assertEqual(t, got, []string{})
+}
+
+func TestReduceCtx(t *testing.T) {
+	got, err := slice.ReduceCtx(context.Background(), []int{1, 2, 3}, func(number int, total int) int {
+		return total + number
+	}, 0)
+	assertEqual(t, err, nil)
+	assertEqual(t, got, 6)
+}
+
+func TestReduceWhileCtx(t *testing.T) {
+	got, err := slice.ReduceWhileCtx(context.Background(), []int{1, 2, 3, 4}, func(number int, total int) (slice.Reduction, int) {
+		if number == 3 {
+			return slice.Halt, total
+		}
+		return slice.Cont, total + number
+	}, 0)
+	assertEqual(t, err, nil)
+	assertEqual(t, got, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count, err := slice.ReduceWhileCtx(ctx, make([]int, 10), func(number int, count int) (slice.Reduction, int) {
+		if count == 3 {
+			cancel()
+		}
+		return slice.Cont, count + 1
+	}, 0)
+	assertEqual(t, errors.Is(err, context.Canceled), true)
+	assertEqual(t, count, 4)
+}
@@ -0,0 +1,175 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrOutOfRange is wrapped by the error FetchOrError returns when the index is out of range.
+var ErrOutOfRange = errors.New("slice: index out of range")
+
+// ElementError is returned by the Err functions when fun fails, recording the index of the element it failed on.
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("slice: element %d: %v", e.Index, e.Err)
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// AllErr returns true if fun returns true for all elements in the slice, stopping at the first error.
+func AllErr[Element any](elements []Element, fun func(Element) (bool, error)) (bool, error) {
+	return ReduceWhileErr(elements, func(element Element, accumulator bool) (Reduction, bool, error) {
+		ok, err := fun(element)
+		if err != nil {
+			return Halt, accumulator, err
+		}
+		if ok {
+			return Cont, true, nil
+		}
+		return Halt, false, nil
+	}, true)
+}
+
+// AnyErr returns true if fun returns true for at least one element in the slice, stopping at the first error.
+func AnyErr[Element any](elements []Element, fun func(Element) (bool, error)) (bool, error) {
+	return ReduceWhileErr(elements, func(element Element, accumulator bool) (Reduction, bool, error) {
+		ok, err := fun(element)
+		if err != nil {
+			return Halt, accumulator, err
+		}
+		if ok {
+			return Halt, true, nil
+		}
+		return Cont, false, nil
+	}, false)
+}
+
+// EachErr invokes fun on each element in the slice, stopping at the first error.
+func EachErr[Element any](elements []Element, fun func(Element) error) error {
+	_, err := ReduceErr(elements, func(element Element, accumulator interface{}) (interface{}, error) {
+		return accumulator, fun(element)
+	}, nil)
+	return err
+}
+
+// FilterErr returns elements where fun returns true, stopping at the first error.
+func FilterErr[Element any](elements []Element, fun func(Element) (bool, error)) ([]Element, error) {
+	filtered, err := ReduceErr(elements, func(element Element, accumulator []Element) ([]Element, error) {
+		ok, err := fun(element)
+		if err != nil || !ok {
+			return accumulator, err
+		}
+		return append(accumulator, element), nil
+	}, make([]Element, 0))
+	if err != nil {
+		return nil, err
+	}
+	return filtered, nil
+}
+
+// FlatMapErr maps the given fun over slice and flattens the result, stopping at the first error.
+func FlatMapErr[Element any](elements []Element, fun func(Element) ([]Element, error)) ([]Element, error) {
+	flattened, err := ReduceErr(elements, func(element Element, accumulator []Element) ([]Element, error) {
+		mapped, err := fun(element)
+		if err != nil {
+			return accumulator, err
+		}
+		return append(accumulator, mapped...), nil
+	}, make([]Element, 0))
+	if err != nil {
+		return nil, err
+	}
+	return flattened, nil
+}
+
+// GroupByErr splits the slice into groups based on key_fun, stopping at the first error.
+func GroupByErr[Element any, GroupBy comparable](elements []Element, fun func(Element) (GroupBy, error)) (map[GroupBy][]Element, error) {
+	groups, err := ReduceErr(elements, func(element Element, accumulator map[GroupBy][]Element) (map[GroupBy][]Element, error) {
+		key, err := fun(element)
+		if err != nil {
+			return accumulator, err
+		}
+		accumulator[key] = append(accumulator[key], element)
+		return accumulator, nil
+	}, make(map[GroupBy][]Element))
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// MapErr invokes fun on each element in the slice, stopping at the first error.
+func MapErr[Element any, ReturnElement any](elements []Element, fun func(Element) (ReturnElement, error)) ([]ReturnElement, error) {
+	mapped, err := ReduceErr(elements, func(element Element, accumulator []ReturnElement) ([]ReturnElement, error) {
+		value, err := fun(element)
+		if err != nil {
+			return accumulator, err
+		}
+		return append(accumulator, value), nil
+	}, make([]ReturnElement, 0, len(elements)))
+	if err != nil {
+		return nil, err
+	}
+	return mapped, nil
+}
+
+// ReduceErr invokes fun on each element in the slice with the accumulator, stopping at the first error.
+//
+// On error the accumulator is returned as it was before the failing element.
+func ReduceErr[Element any, Accumulator any](elements []Element, fun func(Element, Accumulator) (Accumulator, error), accumulator Accumulator) (Accumulator, error) {
+	return ReduceWhileErr(elements, func(element Element, accumulator Accumulator) (Reduction, Accumulator, error) {
+		next, err := fun(element, accumulator)
+		return Cont, next, err
+	}, accumulator)
+}
+
+// ReduceWhileErr invokes fun on each element in the slice with the accumulator until Halt is returned
+// or fun fails.
+//
+// On error the accumulator is returned as it was before the failing element, along with an
+// *ElementError wrapping the error returned by fun.
+func ReduceWhileErr[Element any, Accumulator any](elements []Element, fun func(Element, Accumulator) (Reduction, Accumulator, error), accumulator Accumulator) (Accumulator, error) {
+	for index, element := range elements {
+		reduction, next, err := fun(element, accumulator)
+		if err != nil {
+			return accumulator, &ElementError{Index: index, Err: err}
+		}
+		accumulator = next
+		if reduction == Halt {
+			return accumulator, nil
+		}
+	}
+	return accumulator, nil
+}
+
+// RejectErr returns elements excluding those where fun returns true, stopping at the first error.
+func RejectErr[Element any](elements []Element, fun func(Element) (bool, error)) ([]Element, error) {
+	return FilterErr(elements, func(element Element) (bool, error) {
+		ok, err := fun(element)
+		return !ok, err
+	})
+}
+
+// SortByErr returns a slice sorted according to fun, stopping at the first error.
+//
+// fun is invoked exactly once per element, before any sorting takes place.
+func SortByErr[Element any, SortBy constraints.Ordered](elements []Element, fun func(Element) (SortBy, error), order Order) ([]Element, error) {
+	keys, err := MapErr(elements, fun)
+	if err != nil {
+		return nil, err
+	}
+	return sortIndices(elements, func(i, j int) bool {
+		if order == Asc {
+			return keys[i] < keys[j]
+		}
+		return keys[i] > keys[j]
+	}), nil
+}
@@ -0,0 +1,170 @@
+package slice_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+var errOdd = errors.New("odd number")
+
+func failOnOdd(number int) (bool, error) {
+	if number%2 != 0 {
+		return false, errOdd
+	}
+	return number > 2, nil
+}
+
+func TestElementError(t *testing.T) {
+	_, err := slice.MapErr([]string{"1", "2", "three"}, strconv.Atoi)
+	var elementError *slice.ElementError
+	assertEqual(t, errors.As(err, &elementError), true)
+	assertEqual(t, elementError.Index, 2)
+	assertEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+	assertEqual(t, err.Error(), `slice: element 2: strconv.Atoi: parsing "three": invalid syntax`)
+}
+
+func TestAllErr(t *testing.T) {
+	got, err := slice.AllErr([]int{4, 6, 8}, failOnOdd)
+	assertEqual(t, got, true)
+	assertEqual(t, err, nil)
+
+	got, err = slice.AllErr([]int{4, 2, 3}, failOnOdd)
+	assertEqual(t, got, false)
+	assertEqual(t, err, nil)
+
+	_, err = slice.AllErr([]int{4, 3, 2}, failOnOdd)
+	assertEqual(t, errors.Is(err, errOdd), true)
+
+	got, err = slice.AllErr([]int{}, failOnOdd)
+	assertEqual(t, got, true)
+	assertEqual(t, err, nil)
+}
+
+func TestAnyErr(t *testing.T) {
+	got, err := slice.AnyErr([]int{2, 4, 3}, failOnOdd)
+	assertEqual(t, got, true)
+	assertEqual(t, err, nil)
+
+	_, err = slice.AnyErr([]int{2, 1, 4}, failOnOdd)
+	assertEqual(t, errors.Is(err, errOdd), true)
+}
+
+func TestEachErr(t *testing.T) {
+	visited := make([]int, 0)
+	err := slice.EachErr([]int{2, 4, 5, 6}, func(number int) error {
+		visited = append(visited, number)
+		_, err := failOnOdd(number)
+		return err
+	})
+	assertEqual(t, errors.Is(err, errOdd), true)
+	assertEqual(t, visited, []int{2, 4, 5})
+}
+
+func TestFilterErr(t *testing.T) {
+	got, err := slice.FilterErr([]int{2, 4, 6}, failOnOdd)
+	assertEqual(t, got, []int{4, 6})
+	assertEqual(t, err, nil)
+
+	got, err = slice.FilterErr([]int{2, 4, 5}, failOnOdd)
+	assertEqual(t, got, nil)
+	assertEqual(t, errors.Is(err, errOdd), true)
+}
+
+func TestFlatMapErr(t *testing.T) {
+	double := func(number int) ([]int, error) {
+		if _, err := failOnOdd(number); err != nil {
+			return nil, err
+		}
+		return []int{number, number}, nil
+	}
+	got, err := slice.FlatMapErr([]int{2, 4}, double)
+	assertEqual(t, got, []int{2, 2, 4, 4})
+	assertEqual(t, err, nil)
+
+	_, err = slice.FlatMapErr([]int{2, 3}, double)
+	assertEqual(t, errors.Is(err, errOdd), true)
+}
+
+func TestGroupByErr(t *testing.T) {
+	got, err := slice.GroupByErr([]string{"1", "22", "3"}, func(number string) (int, error) {
+		return len(number), nil
+	})
+	assertEqual(t, got, map[int][]string{1: {"1", "3"}, 2: {"22"}})
+	assertEqual(t, err, nil)
+
+	_, err = slice.GroupByErr([]string{"1", "x"}, strconv.Atoi)
+	assertEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+}
+
+func TestMapErr(t *testing.T) {
+	got, err := slice.MapErr([]string{"1", "2", "3"}, strconv.Atoi)
+	assertEqual(t, got, []int{1, 2, 3})
+	assertEqual(t, err, nil)
+
+	got, err = slice.MapErr([]string{"1", "two", "3"}, strconv.Atoi)
+	assertEqual(t, got, nil)
+	assertEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+}
+
+func TestReduceErr(t *testing.T) {
+	sum := func(number string, total int) (int, error) {
+		value, err := strconv.Atoi(number)
+		return total + value, err
+	}
+	got, err := slice.ReduceErr([]string{"40", "2"}, sum, 0)
+	assertEqual(t, got, 42)
+	assertEqual(t, err, nil)
+
+	got, err = slice.ReduceErr([]string{"40", "2", "x", "8"}, sum, 0)
+	assertEqual(t, got, 42)
+	assertEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+}
+
+func TestReduceWhileErr(t *testing.T) {
+	sum := func(number string, total int) (slice.Reduction, int, error) {
+		if total >= 42 {
+			return slice.Halt, total, nil
+		}
+		value, err := strconv.Atoi(number)
+		return slice.Cont, total + value, err
+	}
+	got, err := slice.ReduceWhileErr([]string{"40", "2", "x"}, sum, 0)
+	assertEqual(t, got, 42)
+	assertEqual(t, err, nil)
+
+	_, err = slice.ReduceWhileErr([]string{"40", "x", "2"}, sum, 0)
+	var elementError *slice.ElementError
+	assertEqual(t, errors.As(err, &elementError), true)
+	assertEqual(t, elementError.Index, 1)
+}
+
+func TestRejectErr(t *testing.T) {
+	got, err := slice.RejectErr([]int{2, 4, 6}, failOnOdd)
+	assertEqual(t, got, []int{2})
+	assertEqual(t, err, nil)
+
+	_, err = slice.RejectErr([]int{1}, failOnOdd)
+	assertEqual(t, errors.Is(err, errOdd), true)
+}
+
+func TestSortByErr(t *testing.T) {
+	calls := 0
+	atoi := func(number string) (int, error) {
+		calls++
+		return strconv.Atoi(number)
+	}
+	got, err := slice.SortByErr([]string{"3", "10", "2", "1"}, atoi, slice.Asc)
+	assertEqual(t, got, []string{"1", "2", "3", "10"})
+	assertEqual(t, err, nil)
+	assertEqual(t, calls, 4)
+
+	got, err = slice.SortByErr([]string{"3", "10", "2", "1"}, atoi, slice.Desc)
+	assertEqual(t, got, []string{"10", "3", "2", "1"})
+	assertEqual(t, err, nil)
+
+	_, err = slice.SortByErr([]string{"3", "ten"}, atoi, slice.Asc)
+	assertEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+}
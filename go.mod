@@ -2,4 +2,4 @@ module github.com/nwjlyons/slice
 
 go 1.18
 
-require golang.org/x/exp v0.0.0-20220414153411-bcd21879b8fd // indirect
+require golang.org/x/exp v0.0.0-20220414153411-bcd21879b8fd
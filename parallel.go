@@ -0,0 +1,118 @@
+package slice
+
+import (
+	"runtime"
+	"sync"
+)
+
+// parallelThreshold is the smallest slice the Parallel functions fan out over,
+// below it the goroutine overhead outweighs the gain and they run sequentially.
+const parallelThreshold = 512
+
+// ParallelFilter returns elements where fun returns true, invoking fun across workers goroutines.
+//
+// The result is in input order. A workers value of zero or less uses runtime.GOMAXPROCS(0),
+// and slices shorter than an internal threshold are filtered sequentially.
+func ParallelFilter[Element any](elements []Element, fun func(Element) bool, workers int) []Element {
+	chunks := parallelChunks(len(elements), workers)
+	if len(chunks) < 2 {
+		return Filter(elements, fun)
+	}
+	filtered := make([][]Element, len(chunks))
+	parallelEach(chunks, func(index int, chunk Pair[int, int]) {
+		filtered[index] = Filter(elements[chunk.First:chunk.Second], fun)
+	})
+	return ConcatAll(filtered...)
+}
+
+// ParallelMap invokes fun on each element in the slice across workers goroutines.
+//
+// The result is in input order. A workers value of zero or less uses runtime.GOMAXPROCS(0),
+// and slices shorter than an internal threshold are mapped sequentially.
+func ParallelMap[Element any, ReturnElement any](elements []Element, fun func(Element) ReturnElement, workers int) []ReturnElement {
+	chunks := parallelChunks(len(elements), workers)
+	if len(chunks) < 2 {
+		return Map(elements, fun)
+	}
+	mapped := make([]ReturnElement, len(elements))
+	parallelEach(chunks, func(_ int, chunk Pair[int, int]) {
+		for index := chunk.First; index < chunk.Second; index++ {
+			mapped[index] = fun(elements[index])
+		}
+	})
+	return mapped
+}
+
+// ParallelReduce splits the slice across workers goroutines, reduces each part with fun
+// and merges the partial results in input order with combine.
+//
+// Every part starts from accumulator, so it must be an identity for combine and must not be
+// mutable state shared between parts. combine must be associative. A workers value of zero or
+// less uses runtime.GOMAXPROCS(0), and slices shorter than an internal threshold are reduced sequentially.
+func ParallelReduce[Element any, Accumulator any](elements []Element, fun func(Element, Accumulator) Accumulator, combine func(Accumulator, Accumulator) Accumulator, accumulator Accumulator, workers int) Accumulator {
+	chunks := parallelChunks(len(elements), workers)
+	if len(chunks) < 2 {
+		return Reduce(elements, fun, accumulator)
+	}
+	reduced := make([]Accumulator, len(chunks))
+	parallelEach(chunks, func(index int, chunk Pair[int, int]) {
+		reduced[index] = Reduce(elements[chunk.First:chunk.Second], fun, accumulator)
+	})
+	return Reduce(reduced[1:], func(partial Accumulator, accumulator Accumulator) Accumulator {
+		return combine(accumulator, partial)
+	}, reduced[0])
+}
+
+// parallelChunks splits length elements into at most workers contiguous [First, Second) ranges.
+// It returns a single range when the work should not be parallelised.
+func parallelChunks(length int, workers int) []Pair[int, int] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if length < parallelThreshold || workers == 1 {
+		return []Pair[int, int]{{First: 0, Second: length}}
+	}
+	if workers > length {
+		workers = length
+	}
+	chunks := make([]Pair[int, int], workers)
+	size, remainder := length/workers, length%workers
+	start := 0
+	for index := range chunks {
+		end := start + size
+		if index < remainder {
+			end++
+		}
+		chunks[index] = Pair[int, int]{First: start, Second: end}
+		start = end
+	}
+	return chunks
+}
+
+// parallelEach invokes fun on each chunk in its own goroutine and waits for them all to finish.
+//
+// A panic in fun is recovered in its goroutine and raised again on the calling goroutine once every chunk
+// has finished, so it can be recovered by the caller just as it can when the work runs sequentially.
+func parallelEach(chunks []Pair[int, int], fun func(int, Pair[int, int])) {
+	var wg sync.WaitGroup
+	var once sync.Once
+	var panicked interface{}
+	wg.Add(len(chunks))
+	for index, chunk := range chunks {
+		go func(index int, chunk Pair[int, int]) {
+			defer wg.Done()
+			defer func() {
+				if value := recover(); value != nil {
+					once.Do(func() {
+						panicked = value
+					})
+				}
+			}()
+			fun(index, chunk)
+		}(index, chunk)
+	}
+	wg.Wait()
+	if panicked != nil {
+		panic(panicked)
+	}
+}
@@ -0,0 +1,82 @@
+package slice_test
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+func TestParallelFilter(t *testing.T) {
+	numbers := make([]int, 10_000)
+	for index := range numbers {
+		numbers[index] = index
+	}
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	assertEqual(t, slice.ParallelFilter(numbers, isEven, 4), slice.Filter(numbers, isEven))
+	assertEqual(t, slice.ParallelFilter(numbers, isEven, 0), slice.Filter(numbers, isEven))
+	assertEqual(t, slice.ParallelFilter([]int{1, 2, 3, 4}, isEven, 4), []int{2, 4})
+	assertEqual(t, slice.ParallelFilter([]int{}, isEven, 4), []int{})
+}
+
+func TestParallelMap(t *testing.T) {
+	numbers := make([]int, 10_000)
+	for index := range numbers {
+		numbers[index] = index
+	}
+	var calls int64
+	got := slice.ParallelMap(numbers, func(number int) string {
+		atomic.AddInt64(&calls, 1)
+		return strconv.Itoa(number)
+	}, 8)
+	assertEqual(t, got, slice.Map(numbers, strconv.Itoa))
+	assertEqual(t, calls, int64(len(numbers)))
+	assertEqual(t, slice.ParallelMap([]int{1, 2, 3}, strconv.Itoa, 8), []string{"1", "2", "3"})
+	assertEqual(t, slice.ParallelMap([]int{}, strconv.Itoa, 8), []string{})
+}
+
+func TestParallelReduce(t *testing.T) {
+	numbers := make([]int, 10_000)
+	for index := range numbers {
+		numbers[index] = index
+	}
+	sum := func(number int, total int) int {
+		return number + total
+	}
+	add := func(left int, right int) int {
+		return left + right
+	}
+	assertEqual(t, slice.ParallelReduce(numbers, sum, add, 0, 3), slice.Sum(numbers))
+	assertEqual(t, slice.ParallelReduce([]int{1, 2, 3}, sum, add, 0, 3), 6)
+
+	letters := make([]string, 1_000)
+	for index := range letters {
+		letters[index] = string(rune('a' + index%26))
+	}
+	concat := func(letter string, accumulator string) string {
+		return accumulator + letter
+	}
+	join := func(left string, right string) string {
+		return left + right
+	}
+	assertEqual(t, slice.ParallelReduce(letters, concat, join, "", 7), slice.Reduce(letters, concat, ""))
+}
+
+func TestParallelPanic(t *testing.T) {
+	for _, length := range []int{10, 10_000} {
+		numbers := make([]int, length)
+		recovered := func() (value interface{}) {
+			defer func() {
+				value = recover()
+			}()
+			slice.ParallelMap(numbers, func(number int) int {
+				panic("boom")
+			}, 4)
+			return nil
+		}()
+		assertEqual(t, recovered, interface{}("boom"))
+	}
+}
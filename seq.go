@@ -0,0 +1,160 @@
+//go:build go1.23
+
+package slice
+
+import (
+	"iter"
+)
+
+// AllSeq returns true if fun returns true for all elements in the sequence.
+func AllSeq[Element any](elements iter.Seq[Element], fun func(Element) bool) bool {
+	return ReduceWhileSeq(elements, func(element Element, accumulator bool) (Reduction, bool) {
+		if fun(element) {
+			return Cont, true
+		}
+		return Halt, false
+	}, true)
+}
+
+// AnySeq returns true if fun returns true for at least one element in the sequence.
+func AnySeq[Element any](elements iter.Seq[Element], fun func(Element) bool) bool {
+	return ReduceWhileSeq(elements, func(element Element, accumulator bool) (Reduction, bool) {
+		if fun(element) {
+			return Halt, true
+		}
+		return Cont, false
+	}, false)
+}
+
+// Collect collects the elements of the sequence into a slice.
+func Collect[Element any](elements iter.Seq[Element]) []Element {
+	return ReduceSeq(elements, func(element Element, accumulator []Element) []Element {
+		return append(accumulator, element)
+	}, make([]Element, 0))
+}
+
+// EachSeq invokes fun on each element in the sequence.
+func EachSeq[Element any](elements iter.Seq[Element], fun func(Element)) {
+	for element := range elements {
+		fun(element)
+	}
+}
+
+// Enumerate returns a sequence of each index and element in the slice.
+func Enumerate[Element any](elements []Element) iter.Seq2[int, Element] {
+	return func(yield func(int, Element) bool) {
+		for index, element := range elements {
+			if !yield(index, element) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq lazily returns elements of the sequence where fun returns true.
+func FilterSeq[Element any](elements iter.Seq[Element], fun func(Element) bool) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for element := range elements {
+			if fun(element) && !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// GroupBySeq splits the sequence into groups based on key_fun. fun is invoked exactly once per element.
+func GroupBySeq[Element any, GroupBy comparable](elements iter.Seq[Element], fun func(Element) GroupBy) map[GroupBy][]Element {
+	return ReduceSeq(elements, func(element Element, accumulator map[GroupBy][]Element) map[GroupBy][]Element {
+		key := fun(element)
+		accumulator[key] = append(accumulator[key], element)
+		return accumulator
+	}, make(map[GroupBy][]Element))
+}
+
+// MapSeq lazily invokes fun on each element in the sequence.
+func MapSeq[Element any, ReturnElement any](elements iter.Seq[Element], fun func(Element) ReturnElement) iter.Seq[ReturnElement] {
+	return func(yield func(ReturnElement) bool) {
+		for element := range elements {
+			if !yield(fun(element)) {
+				return
+			}
+		}
+	}
+}
+
+// Pairs adapts a two value sequence, such as maps.All returns, into a sequence of pairs so it can be used with
+// the other Seq functions.
+func Pairs[First any, Second any](elements iter.Seq2[First, Second]) iter.Seq[Pair[First, Second]] {
+	return func(yield func(Pair[First, Second]) bool) {
+		for first, second := range elements {
+			if !yield(Pair[First, Second]{First: first, Second: second}) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceSeq invokes fun on each element in the sequence with the accumulator.
+func ReduceSeq[Element any, Accumulator any](elements iter.Seq[Element], fun func(Element, Accumulator) Accumulator, accumulator Accumulator) Accumulator {
+	return ReduceWhileSeq(elements, func(element Element, accumulator Accumulator) (Reduction, Accumulator) {
+		return Cont, fun(element, accumulator)
+	}, accumulator)
+}
+
+// ReduceWhileSeq invokes fun on each element in the sequence with the accumulator until Halt is returned,
+// which stops the sequence.
+func ReduceWhileSeq[Element any, Accumulator any](elements iter.Seq[Element], fun func(Element, Accumulator) (Reduction, Accumulator), accumulator Accumulator) Accumulator {
+	reduction := Cont
+	for element := range elements {
+		reduction, accumulator = fun(element, accumulator)
+		if reduction == Halt {
+			break
+		}
+	}
+	return accumulator
+}
+
+// RejectSeq lazily returns elements of the sequence excluding those where fun returns true.
+func RejectSeq[Element any](elements iter.Seq[Element], fun func(Element) bool) iter.Seq[Element] {
+	return FilterSeq(elements, func(element Element) bool {
+		return !fun(element)
+	})
+}
+
+// TakeSeq lazily takes an amount of elements from the beginning of the sequence, stopping it once they are taken.
+func TakeSeq[Element any](elements iter.Seq[Element], amount uint) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		if amount == 0 {
+			return
+		}
+		taken := uint(0)
+		for element := range elements {
+			taken++
+			if !yield(element) || taken == amount {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhileSeq lazily takes the elements from the beginning of the sequence while fun returns a truthy value.
+func TakeWhileSeq[Element any](elements iter.Seq[Element], fun func(Element) bool) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for element := range elements {
+			if !fun(element) || !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the elements in the slice.
+func Values[Element any](elements []Element) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for _, element := range elements {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
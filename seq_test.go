@@ -0,0 +1,151 @@
+//go:build go1.23
+
+package slice_test
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+// naturals returns an endless sequence of natural numbers, counting how many have been produced.
+func naturals(produced *int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		for number := 1; ; number++ {
+			*produced++
+			if !yield(number) {
+				return
+			}
+		}
+	}
+}
+
+func TestAllSeq(t *testing.T) {
+	produced := 0
+	assertEqual(t, slice.AllSeq(naturals(&produced), func(number int) bool {
+		return number < 5
+	}), false)
+	assertEqual(t, produced, 5)
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	assertEqual(t, slice.AllSeq(slices.Values([]int{2, 4}), isEven), true)
+	assertEqual(t, slice.AllSeq(slices.Values([]int{}), isEven), true)
+}
+
+func TestAnySeq(t *testing.T) {
+	produced := 0
+	assertEqual(t, slice.AnySeq(naturals(&produced), func(number int) bool {
+		return number == 3
+	}), true)
+	assertEqual(t, produced, 3)
+}
+
+func TestCollect(t *testing.T) {
+	assertEqual(t, slice.Collect(slices.Values([]int{1, 2, 3})), []int{1, 2, 3})
+	assertEqual(t, slice.Collect(slices.Values([]int{})), []int{})
+}
+
+func TestEachSeq(t *testing.T) {
+	total := 0
+	slice.EachSeq(slices.Values([]int{1, 2, 3}), func(number int) {
+		total += number
+	})
+	assertEqual(t, total, 6)
+}
+
+func TestEnumerate(t *testing.T) {
+	got := make([]string, 0)
+	for index, planet := range slice.Enumerate([]string{"Mercury", "Venus"}) {
+		got = append(got, strconv.Itoa(index)+planet)
+	}
+	assertEqual(t, got, []string{"0Mercury", "1Venus"})
+}
+
+func TestFilterSeq(t *testing.T) {
+	produced := 0
+	evens := slice.FilterSeq(naturals(&produced), func(number int) bool {
+		return number%2 == 0
+	})
+	assertEqual(t, slice.Collect(slice.TakeSeq(evens, 3)), []int{2, 4, 6})
+	assertEqual(t, produced, 6)
+}
+
+func TestGroupBySeq(t *testing.T) {
+	got := slice.GroupBySeq(slices.Values([]string{"Mars", "Venus", "Earth"}), func(planet string) int {
+		return len(planet)
+	})
+	assertEqual(t, got, map[int][]string{4: {"Mars"}, 5: {"Venus", "Earth"}})
+}
+
+func TestMapSeq(t *testing.T) {
+	produced := 0
+	squares := slice.MapSeq(naturals(&produced), func(number int) string {
+		return strconv.Itoa(number * number)
+	})
+	assertEqual(t, slice.Collect(slice.TakeSeq(squares, 3)), []string{"1", "4", "9"})
+	assertEqual(t, produced, 3)
+}
+
+func TestPairs(t *testing.T) {
+	moons := map[string]int{"Earth": 1, "Mars": 2}
+	got := slice.SortBy(slice.Collect(slice.Pairs(maps.All(moons))), func(pair slice.Pair[string, int]) string {
+		return pair.First
+	}, slice.Asc)
+	assertEqual(t, got, []slice.Pair[string, int]{{First: "Earth", Second: 1}, {First: "Mars", Second: 2}})
+}
+
+func TestReduceSeq(t *testing.T) {
+	got := slice.ReduceSeq(maps.Values(map[string]int{"Earth": 1, "Mars": 2}), func(moons int, total int) int {
+		return total + moons
+	}, 0)
+	assertEqual(t, got, 3)
+}
+
+func TestReduceWhileSeq(t *testing.T) {
+	produced := 0
+	got := slice.ReduceWhileSeq(naturals(&produced), func(number int, total int) (slice.Reduction, int) {
+		if total >= 10 {
+			return slice.Halt, total
+		}
+		return slice.Cont, total + number
+	}, 0)
+	assertEqual(t, got, 10)
+	assertEqual(t, produced, 5)
+}
+
+func TestRejectSeq(t *testing.T) {
+	got := slice.RejectSeq(slices.Values([]int{1, 2, 3, 4}), func(number int) bool {
+		return number%2 == 0
+	})
+	assertEqual(t, slice.Collect(got), []int{1, 3})
+}
+
+func TestTakeSeq(t *testing.T) {
+	produced := 0
+	assertEqual(t, slice.Collect(slice.TakeSeq(naturals(&produced), 2)), []int{1, 2})
+	assertEqual(t, produced, 2)
+	assertEqual(t, slice.Collect(slice.TakeSeq(naturals(&produced), 0)), []int{})
+}
+
+func TestTakeWhileSeq(t *testing.T) {
+	produced := 0
+	got := slice.TakeWhileSeq(naturals(&produced), func(number int) bool {
+		return number < 4
+	})
+	assertEqual(t, slice.Collect(got), []int{1, 2, 3})
+}
+
+func TestValues(t *testing.T) {
+	got := make([]string, 0)
+	for planet := range slice.Values([]string{"Mercury", "Venus", "Earth"}) {
+		if planet == "Earth" {
+			break
+		}
+		got = append(got, planet)
+	}
+	assertEqual(t, got, []string{"Mercury", "Venus"})
+}
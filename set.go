@@ -0,0 +1,158 @@
+package slice
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Difference returns the unique elements of left that are not in right, in the order they appear in left.
+func Difference[Element comparable](left []Element, right []Element) []Element {
+	return DifferenceBy(left, right, func(element Element) Element {
+		return element
+	})
+}
+
+// DifferenceBy returns the elements of left whose key according to fun is not the key of any element in right,
+// keeping the first element for each key in the order they appear in left.
+func DifferenceBy[Element any, Key comparable](left []Element, right []Element, fun func(Element) Key) []Element {
+	seen := keySet(right, fun)
+	return Reduce(left, func(element Element, accumulator []Element) []Element {
+		key := fun(element)
+		if _, ok := seen[key]; ok {
+			return accumulator
+		}
+		seen[key] = struct{}{}
+		return append(accumulator, element)
+	}, make([]Element, 0))
+}
+
+// Intersection returns the unique elements of left that are also in right, in the order they appear in left.
+func Intersection[Element comparable](left []Element, right []Element) []Element {
+	return IntersectionBy(left, right, func(element Element) Element {
+		return element
+	})
+}
+
+// IntersectionBy returns the elements of left whose key according to fun is also the key of an element in right,
+// keeping the first element for each key in the order they appear in left.
+func IntersectionBy[Element any, Key comparable](left []Element, right []Element, fun func(Element) Key) []Element {
+	unseen := keySet(right, fun)
+	return Reduce(left, func(element Element, accumulator []Element) []Element {
+		key := fun(element)
+		if _, ok := unseen[key]; !ok {
+			return accumulator
+		}
+		delete(unseen, key)
+		return append(accumulator, element)
+	}, make([]Element, 0))
+}
+
+// SortedDifference returns the unique elements of left that are not in right, where both slices are sorted
+// in the given order as Sort returns them. It merges the slices in a single pass without hashing.
+func SortedDifference[Element constraints.Ordered](left []Element, right []Element, order Order) []Element {
+	return mergeSorted(left, right, order, true, false, false)
+}
+
+// SortedIntersection returns the unique elements in both left and right, where both slices are sorted
+// in the given order as Sort returns them. It merges the slices in a single pass without hashing.
+func SortedIntersection[Element constraints.Ordered](left []Element, right []Element, order Order) []Element {
+	return mergeSorted(left, right, order, false, true, false)
+}
+
+// SortedSymmetricDifference returns the unique elements in exactly one of left and right, where both slices are
+// sorted in the given order as Sort returns them. It merges the slices in a single pass without hashing.
+func SortedSymmetricDifference[Element constraints.Ordered](left []Element, right []Element, order Order) []Element {
+	return mergeSorted(left, right, order, true, false, true)
+}
+
+// SortedUnion returns the unique elements in either left or right, where both slices are sorted in the given
+// order as Sort returns them. It merges the slices in a single pass without hashing.
+func SortedUnion[Element constraints.Ordered](left []Element, right []Element, order Order) []Element {
+	return mergeSorted(left, right, order, true, true, true)
+}
+
+// SymmetricDifference returns the unique elements in exactly one of left and right, those from left first.
+func SymmetricDifference[Element comparable](left []Element, right []Element) []Element {
+	return SymmetricDifferenceBy(left, right, func(element Element) Element {
+		return element
+	})
+}
+
+// SymmetricDifferenceBy returns the elements whose key according to fun appears in exactly one of left and right,
+// keeping the first element for each key, those from left first.
+func SymmetricDifferenceBy[Element any, Key comparable](left []Element, right []Element, fun func(Element) Key) []Element {
+	return append(DifferenceBy(left, right, fun), DifferenceBy(right, left, fun)...)
+}
+
+// Union returns the unique elements in either left or right, in the order they first appear in left then right.
+func Union[Element comparable](left []Element, right []Element) []Element {
+	return UnionBy(left, right, func(element Element) Element {
+		return element
+	})
+}
+
+// UnionBy returns the first element for each key according to fun in either left or right, in the order they
+// first appear in left then right.
+func UnionBy[Element any, Key comparable](left []Element, right []Element, fun func(Element) Key) []Element {
+	return UniqBy(ConcatAll(left, right), fun)
+}
+
+// keySet returns the set of keys according to fun of the elements in the slice.
+func keySet[Element any, Key comparable](elements []Element, fun func(Element) Key) map[Key]struct{} {
+	return Reduce(elements, func(element Element, accumulator map[Key]struct{}) map[Key]struct{} {
+		accumulator[fun(element)] = struct{}{}
+		return accumulator
+	}, make(map[Key]struct{}, len(elements)))
+}
+
+// mergeSorted walks two sorted slices in step, emitting each distinct element once if it is only in left,
+// in both or only in right according to keepLeft, keepBoth and keepRight.
+func mergeSorted[Element constraints.Ordered](left []Element, right []Element, order Order, keepLeft bool, keepBoth bool, keepRight bool) []Element {
+	before := func(a Element, b Element) bool {
+		if order == Asc {
+			return a < b
+		}
+		return a > b
+	}
+	// skip always moves past the element at index, even one such as NaN that is not equal to itself.
+	skip := func(elements []Element, index int) int {
+		element := elements[index]
+		index++
+		for index < len(elements) && elements[index] == element {
+			index++
+		}
+		return index
+	}
+	merged := make([]Element, 0)
+	i, j := 0, 0
+	for i < len(left) || j < len(right) {
+		switch {
+		case j == len(right) || (i < len(left) && before(left[i], right[j])):
+			if keepLeft {
+				merged = append(merged, left[i])
+			}
+			i = skip(left, i)
+		case i == len(left) || before(right[j], left[i]):
+			if keepRight {
+				merged = append(merged, right[j])
+			}
+			j = skip(right, j)
+		case left[i] != left[i]:
+			// An element such as NaN that is not equal to itself is distinct from everything, so it is only in left.
+			if keepLeft {
+				merged = append(merged, left[i])
+			}
+			i = skip(left, i)
+		case right[j] != right[j]:
+			if keepRight {
+				merged = append(merged, right[j])
+			}
+			j = skip(right, j)
+		default:
+			if keepBoth {
+				merged = append(merged, left[i])
+			}
+			i, j = skip(left, i), skip(right, j)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,87 @@
+package slice_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+func TestDifference(t *testing.T) {
+	assertEqual(t, slice.Difference([]int{5, 1, 2, 3, 1, 4}, []int{2, 4, 6}), []int{5, 1, 3})
+	assertEqual(t, slice.Difference([]int{1, 2}, []int{}), []int{1, 2})
+	assertEqual(t, slice.Difference([]int{}, []int{1, 2}), []int{})
+}
+
+func TestDifferenceBy(t *testing.T) {
+	got := slice.DifferenceBy([]string{"Go", "rust", "ELIXIR", "go"}, []string{"Rust"}, strings.ToLower)
+	assertEqual(t, got, []string{"Go", "ELIXIR"})
+}
+
+func TestIntersection(t *testing.T) {
+	assertEqual(t, slice.Intersection([]int{5, 4, 1, 2, 4, 3}, []int{3, 4, 4, 6}), []int{4, 3})
+	assertEqual(t, slice.Intersection([]int{1, 2}, []int{}), []int{})
+}
+
+func TestIntersectionBy(t *testing.T) {
+	got := slice.IntersectionBy([]string{"Go", "rust", "go", "ELIXIR"}, []string{"GO", "elixir"}, strings.ToLower)
+	assertEqual(t, got, []string{"Go", "ELIXIR"})
+}
+
+func TestSortedDifference(t *testing.T) {
+	assertEqual(t, slice.SortedDifference([]int{1, 1, 2, 3, 4, 5}, []int{2, 4, 4, 6}, slice.Asc), []int{1, 3, 5})
+	assertEqual(t, slice.SortedDifference([]int{5, 4, 3, 2, 1}, []int{6, 4, 2}, slice.Desc), []int{5, 3, 1})
+	assertEqual(t, slice.SortedDifference([]int{1, 1}, []int{1}, slice.Asc), []int{})
+	assertEqual(t, fmt.Sprint(slice.SortedDifference([]float64{math.NaN(), 1}, []float64{math.NaN(), 1}, slice.Asc)), "[NaN]")
+}
+
+func TestSortedIntersection(t *testing.T) {
+	assertEqual(t, slice.SortedIntersection([]int{1, 2, 3, 4, 4}, []int{3, 4, 4, 6}, slice.Asc), []int{3, 4})
+	assertEqual(t, slice.SortedIntersection([]int{4, 3, 2}, []int{6, 4, 3}, slice.Desc), []int{4, 3})
+	assertEqual(t, slice.SortedIntersection([]float64{math.NaN(), 1}, []float64{math.NaN(), 1}, slice.Asc), []float64{1})
+}
+
+func TestSortedSymmetricDifference(t *testing.T) {
+	got := slice.SortedSymmetricDifference([]int{1, 2, 2, 3, 5}, []int{2, 4, 5, 5, 6}, slice.Asc)
+	assertEqual(t, got, []int{1, 3, 4, 6})
+	nan := slice.SortedSymmetricDifference([]float64{math.NaN(), 1}, []float64{math.NaN(), 2}, slice.Asc)
+	assertEqual(t, fmt.Sprint(nan), "[NaN NaN 1 2]")
+}
+
+func TestSortedUnion(t *testing.T) {
+	assertEqual(t, slice.SortedUnion([]int{1, 3, 3, 5}, []int{2, 3, 6}, slice.Asc), []int{1, 2, 3, 5, 6})
+	assertEqual(t, slice.SortedUnion([]string{"c", "a"}, []string{"b", "a"}, slice.Desc), []string{"c", "b", "a"})
+	assertEqual(t, slice.SortedUnion([]int{}, []int{}, slice.Asc), []int{})
+	assertEqual(t, fmt.Sprint(slice.SortedUnion([]float64{math.NaN()}, []float64{1}, slice.Asc)), "[NaN 1]")
+	assertEqual(t, fmt.Sprint(slice.SortedUnion([]float64{math.NaN(), math.NaN()}, []float64{1}, slice.Asc)), "[NaN NaN 1]")
+
+	left, right := []int{9, 2, 7, 2, 4}, []int{3, 7, 1, 9}
+	assertEqual(t,
+		slice.SortedUnion(slice.Sort(left, slice.Asc), slice.Sort(right, slice.Asc), slice.Asc),
+		slice.Sort(slice.Union(left, right), slice.Asc))
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	assertEqual(t, slice.SymmetricDifference([]int{1, 2, 3, 3}, []int{4, 3, 2, 5, 4}), []int{1, 4, 5})
+}
+
+func TestSymmetricDifferenceBy(t *testing.T) {
+	got := slice.SymmetricDifferenceBy([]string{"Go", "Rust"}, []string{"rust", "Zig"}, strings.ToLower)
+	assertEqual(t, got, []string{"Go", "Zig"})
+}
+
+func TestUnion(t *testing.T) {
+	assertEqual(t, slice.Union([]int{3, 1, 3}, []int{2, 1, 4}), []int{3, 1, 2, 4})
+	assertEqual(t, slice.Union([]int{}, []int{}), []int{})
+
+	left := make([]int, 1, 4)
+	slice.Union(left, []int{1, 2, 3})
+	assertEqual(t, left[:4], []int{0, 0, 0, 0})
+}
+
+func TestUnionBy(t *testing.T) {
+	got := slice.UnionBy([]string{"Go", "rust"}, []string{"GO", "Zig", "RUST"}, strings.ToLower)
+	assertEqual(t, got, []string{"Go", "rust", "Zig"})
+}
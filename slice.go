@@ -1,8 +1,18 @@
+// Package slice implements Elixir's Enum module in Go using generics.
+//
+// Unless documented otherwise, every function returning a slice returns a newly allocated slice which does not
+// share memory with its arguments, so either can be modified or appended to without affecting the other. Elements
+// are copied shallowly, pointers and nested slices still refer to the same values. The exceptions are Windows, which
+// returns views of its input, the Append functions, which append to dst, and the InPlace functions, which reuse
+// their input.
 package slice
 
 import (
+	"fmt"
+	"math"
 	"math/rand"
 	"sort"
+	"sync"
 	"time"
 
 	"golang.org/x/exp/constraints"
@@ -22,23 +32,70 @@ const (
 	Desc
 )
 
-type pair[Element any] struct {
-	left  Element
-	right Element
+// Pair holds two values, such as the elements of two slices at the same index.
+type Pair[First any, Second any] struct {
+	First  First
+	Second Second
+}
+
+// Triple holds three values, such as the elements of three slices at the same index.
+type Triple[First any, Second any, Third any] struct {
+	First  First
+	Second Second
+	Third  Third
 }
 
 type Number interface {
 	constraints.Integer | constraints.Float
 }
 
-// All returns true if fun returns true for all elements in the slice.
+// SortKey is one key of a multi-key sort, built with By and passed to SortByKeys.
+type SortKey[Element any] struct {
+	// compareBy evaluates the key of every element once and returns a comparison of the elements at two indices.
+	compareBy func([]Element) func(int, int) int
+}
+
+// RandomSource is a source of random numbers for RandomFrom and ShuffleFrom. *rand.Rand satisfies it.
+type RandomSource interface {
+	// Intn returns a non-negative random number in [0, n).
+	Intn(n int) int
+}
+
+type lockedRandomSource struct {
+	mutex  sync.Mutex
+	source RandomSource
+}
+
+func (l *lockedRandomSource) Intn(n int) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.source.Intn(n)
+}
+
+func (l *lockedRandomSource) Shuffle(n int, swap func(i, j int)) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	shuffle(l.source, n, swap)
+}
+
+// defaultRandomSource is used by Random and Shuffle when no seed is given. It is seeded from the clock once,
+// rather than relying on the global math/rand generator, which is only seeded automatically from Go 1.20.
+var defaultRandomSource = LockedRandomSource(rand.New(rand.NewSource(time.Now().UnixNano())))
+
+// shuffler is implemented by sources with their own shuffle, such as *rand.Rand, which ShuffleFrom prefers
+// so that its order matches the source's.
+type shuffler interface {
+	Shuffle(n int, swap func(i, j int))
+}
+
+// All returns true if fun returns true for all elements in the slice. It returns true for an empty slice.
 func All[Element any](elements []Element, fun func(Element) bool) bool {
 	return ReduceWhile(elements, func(element Element, accumulator bool) (Reduction, bool) {
 		if fun(element) {
 			return Cont, true
 		}
 		return Halt, false
-	}, false)
+	}, true)
 }
 
 // Any returns true if fun returns true for at least one element in the slice.
@@ -51,18 +108,138 @@ func Any[Element any](elements []Element, fun func(Element) bool) bool {
 	}, false)
 }
 
-// At finds the element at the given index (zero-based).
+// AppendFilter appends the elements where fun returns true to dst and returns the extended slice.
+func AppendFilter[Element any](dst []Element, elements []Element, fun func(Element) bool) []Element {
+	return Reduce(elements, func(element Element, accumulator []Element) []Element {
+		if fun(element) {
+			return append(accumulator, element)
+		}
+		return accumulator
+	}, dst)
+}
+
+// AppendMap appends the result of invoking fun on each element in the slice to dst and returns the extended slice.
+func AppendMap[Element any, ReturnElement any](dst []ReturnElement, elements []Element, fun func(Element) ReturnElement) []ReturnElement {
+	return Reduce(elements, func(element Element, accumulator []ReturnElement) []ReturnElement {
+		return append(accumulator, fun(element))
+	}, dst)
+}
+
+// At finds the element at the given index (zero-based), or returns defaultValue if the index is out of range.
+// A negative index counts back from the end of the slice, so -1 is the last element.
 func At[Element any](elements []Element, index int, defaultValue Element) Element {
-	if index >= 0 && index < len(elements) {
-		return elements[index]
+	if element, ok := Fetch(elements, index); ok {
+		return element
 	} else {
 		return defaultValue
 	}
 }
 
+// BinarySearch searches the slice sorted in the given order, as Sort returns it, for target. It returns the index
+// of target and true if it is found, otherwise the index where target would be inserted to keep the slice sorted and false.
+func BinarySearch[Element constraints.Ordered](elements []Element, target Element, order Order) (int, bool) {
+	return BinarySearchBy(elements, target, func(element Element) Element {
+		return element
+	}, order)
+}
+
+// BinarySearchBy searches the slice sorted by fun in the given order, as SortBy returns it, for an element whose key
+// equals target. It returns the index of the first such element and true if one is found, otherwise the index where an
+// element with that key would be inserted to keep the slice sorted and false.
+func BinarySearchBy[Element any, SearchBy constraints.Ordered](elements []Element, target SearchBy, fun func(Element) SearchBy, order Order) (int, bool) {
+	index := sort.Search(len(elements), func(index int) bool {
+		if order == Asc {
+			return fun(elements[index]) >= target
+		}
+		return fun(elements[index]) <= target
+	})
+	return index, index < len(elements) && fun(elements[index]) == target
+}
+
+// By returns a sort key for SortByKeys ordering elements by the value of fun in the given order.
+// fun is invoked exactly once per element being sorted.
+func By[Element any, SortBy constraints.Ordered](fun func(Element) SortBy, order Order) SortKey[Element] {
+	return SortKey[Element]{compareBy: func(elements []Element) func(int, int) int {
+		keys := Map(elements, fun)
+		return func(i, j int) int {
+			comparison := 0
+			if keys[i] < keys[j] {
+				comparison = -1
+			} else if keys[i] > keys[j] {
+				comparison = 1
+			}
+			if order == Desc {
+				return -comparison
+			}
+			return comparison
+		}
+	}}
+}
+
+// ChunkBy splits the slice on every element for which fun returns a new value.
+func ChunkBy[Element any, ChunkBy comparable](elements []Element, fun func(Element) ChunkBy) [][]Element {
+	var previous ChunkBy
+	return Reduce(elements, func(element Element, accumulator [][]Element) [][]Element {
+		key := fun(element)
+		if len(accumulator) == 0 || key != previous {
+			previous = key
+			return append(accumulator, []Element{element})
+		}
+		last := len(accumulator) - 1
+		accumulator[last] = append(accumulator[last], element)
+		return accumulator
+	}, make([][]Element, 0))
+}
+
+// ChunkEvery splits the slice into chunks of count elements, where each new chunk starts step elements into the slice.
+//
+// If the last chunk does not have count elements it is filled with elements from leftover. If leftover
+// does not have enough elements, or is nil or empty, the last chunk is returned with fewer than count elements.
+// Use ChunkEveryDiscard to drop the incomplete chunk instead. It panics if count or step is zero.
+func ChunkEvery[Element any](elements []Element, count uint, step uint, leftover []Element) [][]Element {
+	return chunkEvery(elements, count, step, leftover, false)
+}
+
+// ChunkEveryDiscard splits the slice into chunks of count elements, where each new chunk starts step elements
+// into the slice, discarding the last chunk if it does not have count elements (Elixir's :discard).
+// It panics if count or step is zero.
+func ChunkEveryDiscard[Element any](elements []Element, count uint, step uint) [][]Element {
+	return chunkEvery(elements, count, step, nil, true)
+}
+
+// ChunkWhile chunks the slice with fine grained control when every chunk is emitted.
+//
+// chunkFun receives each element with the accumulator and returns the next accumulator along with a chunk
+// and whether to emit it. Returning Halt stops chunking. Once the slice is exhausted or halted afterFun is
+// invoked with the final accumulator and may emit one last chunk.
+func ChunkWhile[Element any, Chunk any, Accumulator any](elements []Element, chunkFun func(Element, Accumulator) (Reduction, Accumulator, Chunk, bool), afterFun func(Accumulator) (Chunk, bool), accumulator Accumulator) []Chunk {
+	chunks := make([]Chunk, 0)
+	accumulator = ReduceWhile(elements, func(element Element, accumulator Accumulator) (Reduction, Accumulator) {
+		reduction, accumulator, chunk, emit := chunkFun(element, accumulator)
+		if emit {
+			chunks = append(chunks, chunk)
+		}
+		return reduction, accumulator
+	}, accumulator)
+	if chunk, emit := afterFun(accumulator); emit {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
 // Concat concatenates the enumerable on the right with the enumerable on the left.
 func Concat[Element any](left []Element, right []Element) []Element {
-	return append(left, right...)
+	return ConcatAll(left, right)
+}
+
+// ConcatAll concatenates all the slices in order.
+func ConcatAll[Element any](slices ...[]Element) []Element {
+	concatenated := make([]Element, 0, SumBy(slices, func(elements []Element) int {
+		return len(elements)
+	}))
+	return Reduce(slices, func(elements []Element, accumulator []Element) []Element {
+		return append(accumulator, elements...)
+	}, concatenated)
 }
 
 // Count counts the number of elements in the slice.
@@ -80,6 +257,65 @@ func CountBy[Element any](elements []Element, fun func(Element) bool) int {
 	}, 0)
 }
 
+// CumulativeProduct returns the running product of the elements, one value per element.
+func CumulativeProduct[Element Number](elements []Element) []Element {
+	return Scan(elements, func(element Element, accumulator Element) Element {
+		return element * accumulator
+	}, 1)
+}
+
+// CumulativeSum returns the running total of the elements, one value per element.
+func CumulativeSum[Element Number](elements []Element) []Element {
+	return Scan(elements, func(element Element, accumulator Element) Element {
+		return element + accumulator
+	}, 0)
+}
+
+// Cycle repeats the elements in the slice until amount elements have been produced.
+// It returns an empty slice if the slice is empty.
+func Cycle[Element any](elements []Element, amount uint) []Element {
+	if len(elements) == 0 {
+		return make([]Element, 0)
+	}
+	cycled := make([]Element, amount)
+	for index := range cycled {
+		cycled[index] = elements[index%len(elements)]
+	}
+	return cycled
+}
+
+// Drop drops an amount of elements from the beginning of the slice.
+func Drop[Element any](elements []Element, amount uint) []Element {
+	if amount > uint(len(elements)) {
+		amount = uint(len(elements))
+	}
+	return append(make([]Element, 0, len(elements)-int(amount)), elements[amount:]...)
+}
+
+// DropEvery returns a slice of every nth element dropped, starting with the first element.
+// If nth is zero the slice is returned unchanged.
+func DropEvery[Element any](elements []Element, nth uint) []Element {
+	index := uint(0)
+	return Reject(elements, func(element Element) bool {
+		index++
+		return nth != 0 && (index-1)%nth == 0
+	})
+}
+
+// DropLast drops an amount of elements from the end of the slice.
+func DropLast[Element any](elements []Element, amount uint) []Element {
+	if amount > uint(len(elements)) {
+		amount = uint(len(elements))
+	}
+	return Take(elements, uint(len(elements))-amount)
+}
+
+// DropWhile drops elements at the beginning of the slice while fun returns a truthy value.
+func DropWhile[Element any](elements []Element, fun func(Element) bool) []Element {
+	_, right := SplitWhile(elements, fun)
+	return append(make([]Element, 0, len(right)), right...)
+}
+
 // Each invokes fun on each element in the slice.
 func Each[Element any](elements []Element, fun func(Element)) {
 	Reduce(elements, func(element Element, accumulator interface{}) interface{} {
@@ -88,14 +324,101 @@ func Each[Element any](elements []Element, fun func(Element)) {
 	}, nil)
 }
 
+// Fetch finds the element at the given index (zero-based), or returns false if the index is out of range.
+// A negative index counts back from the end of the slice, so -1 is the last element.
+func Fetch[Element any](elements []Element, index int) (Element, bool) {
+	if index < 0 {
+		index += len(elements)
+	}
+	if index >= 0 && index < len(elements) {
+		return elements[index], true
+	}
+	var zero Element
+	return zero, false
+}
+
+// FetchOrError finds the element at the given index (zero-based), or returns an error wrapping ErrOutOfRange
+// if the index is out of range. A negative index counts back from the end of the slice.
+func FetchOrError[Element any](elements []Element, index int) (Element, error) {
+	if element, ok := Fetch(elements, index); ok {
+		return element, nil
+	}
+	var zero Element
+	return zero, fmt.Errorf("%w: index %d with length %d", ErrOutOfRange, index, len(elements))
+}
+
 // Filter returns elements where fun returns true.
 func Filter[Element any](elements []Element, fun func(Element) bool) []Element {
-	return Reduce(elements, func(element Element, accumulator []Element) []Element {
+	return AppendFilter(make([]Element, 0), elements, fun)
+}
+
+// FilterInPlace keeps the elements where fun returns true by moving them to the front of elements,
+// returning the shortened slice without allocating. The original contents of elements are overwritten.
+func FilterInPlace[Element any](elements []Element, fun func(Element) bool) []Element {
+	kept := 0
+	for _, element := range elements {
 		if fun(element) {
-			return append(accumulator, element)
+			elements[kept] = element
+			kept++
 		}
-		return accumulator
-	}, make([]Element, 0))
+	}
+	var zero Element
+	for index := kept; index < len(elements); index++ {
+		elements[index] = zero
+	}
+	return elements[:kept]
+}
+
+// Find returns the first element for which fun returns true, or false if there is none.
+func Find[Element any](elements []Element, fun func(Element) bool) (Element, bool) {
+	index := FindIndex(elements, fun)
+	if index < 0 {
+		var zero Element
+		return zero, false
+	}
+	return elements[index], true
+}
+
+// FindIndex returns the index of the first element for which fun returns true, or -1 if there is none.
+func FindIndex[Element any](elements []Element, fun func(Element) bool) int {
+	for index, element := range elements {
+		if fun(element) {
+			return index
+		}
+	}
+	return -1
+}
+
+// FindLast returns the last element for which fun returns true, or false if there is none.
+func FindLast[Element any](elements []Element, fun func(Element) bool) (Element, bool) {
+	index := FindLastIndex(elements, fun)
+	if index < 0 {
+		var zero Element
+		return zero, false
+	}
+	return elements[index], true
+}
+
+// FindLastIndex returns the index of the last element for which fun returns true, or -1 if there is none.
+func FindLastIndex[Element any](elements []Element, fun func(Element) bool) int {
+	for index := len(elements) - 1; index >= 0; index-- {
+		if fun(elements[index]) {
+			return index
+		}
+	}
+	return -1
+}
+
+// FindValue returns the first result of fun that is not the zero value, or false if there is none.
+func FindValue[Element any, Value comparable](elements []Element, fun func(Element) Value) (Value, bool) {
+	var zero Value
+	value := ReduceWhile(elements, func(element Element, accumulator Value) (Reduction, Value) {
+		if value := fun(element); value != zero {
+			return Halt, value
+		}
+		return Cont, accumulator
+	}, zero)
+	return value, value != zero
 }
 
 // FlatMap maps the given fun over slice and flattens the result.
@@ -120,10 +443,11 @@ func FrequenciesBy[Element any, Key comparable](elements []Element, fun func(Ele
 	}, make(map[Key]int))
 }
 
-// GroupBy splits the slice into groups based on key_fun.
+// GroupBy splits the slice into groups based on key_fun. fun is invoked exactly once per element.
 func GroupBy[Element any, GroupBy comparable](elements []Element, fun func(Element) GroupBy) map[GroupBy][]Element {
 	return Reduce(elements, func(element Element, accumulator map[GroupBy][]Element) map[GroupBy][]Element {
-		accumulator[fun(element)] = append(accumulator[fun(element)], element)
+		key := fun(element)
+		accumulator[key] = append(accumulator[key], element)
 		return accumulator
 	}, make(map[GroupBy][]Element))
 }
@@ -145,93 +469,300 @@ func IsMemberBy[Element any, IsMemberBy comparable](elements []Element, member E
 	}, false)
 }
 
+// Iterate returns amount elements, starting with start and invoking fun on the previous element
+// to produce each one after it.
+func Iterate[Element any](start Element, fun func(Element) Element, amount uint) []Element {
+	iterated := make([]Element, 0, amount)
+	for index := uint(0); index < amount; index++ {
+		if index > 0 {
+			start = fun(start)
+		}
+		iterated = append(iterated, start)
+	}
+	return iterated
+}
+
+// LockedRandomSource wraps source so it can be shared between goroutines.
+func LockedRandomSource(source RandomSource) RandomSource {
+	return &lockedRandomSource{source: source}
+}
+
 // Map invokes fun on each element in the slice.
 func Map[Element any, ReturnElement any](elements []Element, fun func(Element) ReturnElement) []ReturnElement {
-	return Reduce(elements, func(element Element, accumulator []ReturnElement) []ReturnElement {
-		return append(accumulator, fun(element))
-	}, make([]ReturnElement, 0))
+	return AppendMap(make([]ReturnElement, 0, len(elements)), elements, fun)
 }
 
-// Max returns the maximum element in the slice.
+// Max returns the maximum element in the slice. It panics if the slice is empty.
 func Max[Element constraints.Ordered](elements []Element) Element {
 	return MaxBy(elements, func(element Element) Element {
 		return element
 	})
 }
 
-// MaxBy returns the maximum element in the slice according to fun.
+// MaxBy returns the maximum element in the slice according to fun. It panics if the slice is empty.
+// fun is invoked exactly once per element.
 func MaxBy[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy) Element {
+	max, maxKey := elements[0], fun(elements[0])
+	Each(elements[1:], func(element Element) {
+		if key := fun(element); key > maxKey {
+			max, maxKey = element, key
+		}
+	})
+	return max
+}
+
+// MaxByOK returns the maximum element in the slice according to fun, or false if the slice is empty.
+func MaxByOK[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy) (Element, bool) {
+	if len(elements) == 0 {
+		var zero Element
+		return zero, false
+	}
+	return MaxBy(elements, fun), true
+}
+
+// MaxByOr returns the maximum element in the slice according to fun, or defaultValue if the slice is empty.
+func MaxByOr[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy, defaultValue Element) Element {
+	if max, ok := MaxByOK(elements, fun); ok {
+		return max
+	}
+	return defaultValue
+}
+
+// MaxOK returns the maximum element in the slice, or false if the slice is empty.
+func MaxOK[Element constraints.Ordered](elements []Element) (Element, bool) {
+	return MaxByOK(elements, func(element Element) Element {
+		return element
+	})
+}
+
+// MaxOr returns the maximum element in the slice, or defaultValue if the slice is empty.
+func MaxOr[Element constraints.Ordered](elements []Element, defaultValue Element) Element {
+	return MaxByOr(elements, func(element Element) Element {
+		return element
+	}, defaultValue)
+}
+
+// MaxWith returns the maximum element in the slice according to compare, which returns a negative number,
+// zero or a positive number when its first argument is less than, equal to or greater than its second,
+// as cmp.Compare does. It panics if the slice is empty.
+func MaxWith[Element any](elements []Element, compare func(Element, Element) int) Element {
 	return Reduce(elements, func(element Element, max Element) Element {
-		if fun(element) > fun(max) {
+		if compare(element, max) > 0 {
 			max = element
 		}
 		return max
 	}, elements[0])
 }
 
-// Min returns the minimum element in the slice.
+// Min returns the minimum element in the slice. It panics if the slice is empty.
 func Min[Element constraints.Ordered](elements []Element) Element {
 	return MinBy(elements, func(element Element) Element {
 		return element
 	})
 }
 
-// MinBy returns the minimum element in the slice according to fun.
+// MinBy returns the minimum element in the slice according to fun. It panics if the slice is empty.
+// fun is invoked exactly once per element.
 func MinBy[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy) Element {
-	return Reduce(elements, func(element Element, min Element) Element {
-		if fun(element) < fun(min) {
-			min = element
+	min, minKey := elements[0], fun(elements[0])
+	Each(elements[1:], func(element Element) {
+		if key := fun(element); key < minKey {
+			min, minKey = element, key
 		}
+	})
+	return min
+}
+
+// MinByOK returns the minimum element in the slice according to fun, or false if the slice is empty.
+func MinByOK[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy) (Element, bool) {
+	if len(elements) == 0 {
+		var zero Element
+		return zero, false
+	}
+	return MinBy(elements, fun), true
+}
+
+// MinByOr returns the minimum element in the slice according to fun, or defaultValue if the slice is empty.
+func MinByOr[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy, defaultValue Element) Element {
+	if min, ok := MinByOK(elements, fun); ok {
 		return min
-	}, elements[0])
+	}
+	return defaultValue
 }
 
-// MinMax returns the minimum and maximum element in the slice.
+// MinMax returns the minimum and maximum element in the slice. It panics if the slice is empty.
 func MinMax[Element constraints.Ordered](elements []Element) (Element, Element) {
 	return MinMaxBy(elements, func(element Element) Element {
 		return element
 	})
 }
 
-// MinMaxBy returns the minimum and maximum element in the slice according to fun.
+// MinMaxBy returns the minimum and maximum element in the slice according to fun. It panics if the slice is empty.
+// fun is invoked exactly once per element.
 func MinMaxBy[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy) (Element, Element) {
-	result := Reduce(elements, func(element Element, accumulator pair[Element]) pair[Element] {
-		if fun(element) < fun(accumulator.left) {
-			accumulator.left = element
+	min, minKey := elements[0], fun(elements[0])
+	max, maxKey := min, minKey
+	Each(elements[1:], func(element Element) {
+		key := fun(element)
+		if key < minKey {
+			min, minKey = element, key
+		}
+		if key > maxKey {
+			max, maxKey = element, key
+		}
+	})
+	return min, max
+}
+
+// MinMaxByOK returns the minimum and maximum element in the slice according to fun, or false if the slice is empty.
+func MinMaxByOK[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy) (Element, Element, bool) {
+	if len(elements) == 0 {
+		var zero Element
+		return zero, zero, false
+	}
+	min, max := MinMaxBy(elements, fun)
+	return min, max, true
+}
+
+// MinMaxByOr returns the minimum and maximum element in the slice according to fun, or defaultValue twice if the slice is empty.
+func MinMaxByOr[Element any, CompareBy constraints.Ordered](elements []Element, fun func(Element) CompareBy, defaultValue Element) (Element, Element) {
+	if min, max, ok := MinMaxByOK(elements, fun); ok {
+		return min, max
+	}
+	return defaultValue, defaultValue
+}
+
+// MinMaxOK returns the minimum and maximum element in the slice, or false if the slice is empty.
+func MinMaxOK[Element constraints.Ordered](elements []Element) (Element, Element, bool) {
+	return MinMaxByOK(elements, func(element Element) Element {
+		return element
+	})
+}
+
+// MinMaxOr returns the minimum and maximum element in the slice, or defaultValue twice if the slice is empty.
+func MinMaxOr[Element constraints.Ordered](elements []Element, defaultValue Element) (Element, Element) {
+	return MinMaxByOr(elements, func(element Element) Element {
+		return element
+	}, defaultValue)
+}
+
+// MinMaxWith returns the minimum and maximum element in the slice according to compare, see MaxWith.
+// It panics if the slice is empty.
+func MinMaxWith[Element any](elements []Element, compare func(Element, Element) int) (Element, Element) {
+	result := Reduce(elements, func(element Element, accumulator Pair[Element, Element]) Pair[Element, Element] {
+		if compare(element, accumulator.First) < 0 {
+			accumulator.First = element
 		}
-		if fun(element) > fun(accumulator.right) {
-			accumulator.right = element
+		if compare(element, accumulator.Second) > 0 {
+			accumulator.Second = element
 		}
 		return accumulator
-	}, pair[Element]{left: elements[0], right: elements[0]})
+	}, Pair[Element, Element]{First: elements[0], Second: elements[0]})
+
+	return result.First, result.Second
+}
+
+// MinOK returns the minimum element in the slice, or false if the slice is empty.
+func MinOK[Element constraints.Ordered](elements []Element) (Element, bool) {
+	return MinByOK(elements, func(element Element) Element {
+		return element
+	})
+}
+
+// MinOr returns the minimum element in the slice, or defaultValue if the slice is empty.
+func MinOr[Element constraints.Ordered](elements []Element, defaultValue Element) Element {
+	return MinByOr(elements, func(element Element) Element {
+		return element
+	}, defaultValue)
+}
 
-	return result.left, result.right
+// MinWith returns the minimum element in the slice according to compare, see MaxWith. It panics if the slice is empty.
+func MinWith[Element any](elements []Element, compare func(Element, Element) int) Element {
+	return Reduce(elements, func(element Element, min Element) Element {
+		if compare(element, min) < 0 {
+			min = element
+		}
+		return min
+	}, elements[0])
 }
 
-// Product returns the product of all elements.
+// Product returns the product of all elements, or 1 if the slice is empty.
 func Product[Element Number](elements []Element) Element {
 	return ProductBy(elements, func(element Element) Element {
 		return element
 	})
 }
 
-// ProductBy returns the product of all elements according to fun.
+// ProductBy returns the product of all elements according to fun, or 1 if the slice is empty.
 func ProductBy[Element any, Product Number](elements []Element, fun func(Element) Product) Product {
-	return Reduce(elements[1:], func(element Element, accumulator Product) Product {
+	return Reduce(elements, func(element Element, accumulator Product) Product {
 		return fun(element) * accumulator
-	}, fun(elements[0]))
+	}, 1)
 }
 
-// Random returns a random element from the slice.
+// Random returns a random element from the slice. It panics if the slice is empty.
+//
+// Without a seed it draws from a package generator seeded from the clock once, leaving the global math/rand
+// generator untouched. With a seed it uses a private generator, so it is equivalent to RandomFrom with
+// rand.New(rand.NewSource(seed)).
 func Random[Element any](elements []Element, seed ...int64) Element {
-	if len(seed) == 0 {
-		rand.Seed(time.Now().UTC().UnixNano())
-	} else if len(seed) == 1 {
-		rand.Seed(seed[0])
+	return RandomFrom(elements, seededSource(seed))
+}
+
+// RandomFrom returns a random element from the slice using source. It panics if the slice is empty.
+func RandomFrom[Element any](elements []Element, source RandomSource) Element {
+	return elements[source.Intn(len(elements))]
+}
+
+// RandomOK returns a random element from the slice, or false if the slice is empty.
+func RandomOK[Element any](elements []Element, seed ...int64) (Element, bool) {
+	if len(elements) == 0 {
+		var zero Element
+		return zero, false
+	}
+	return Random(elements, seed...), true
+}
+
+// RandomOr returns a random element from the slice, or defaultValue if the slice is empty.
+func RandomOr[Element any](elements []Element, defaultValue Element, seed ...int64) Element {
+	if element, ok := RandomOK(elements, seed...); ok {
+		return element
+	}
+	return defaultValue
+}
+
+// Range returns the numbers from start to stop inclusive, counting by step.
+//
+// A step that moves away from stop returns an empty slice. A step of zero panics, as does a NaN or infinite
+// start, stop or step. Each number is
+// computed as start plus a multiple of step rather than by repeated addition. For floats, stop is
+// included when it is within a tiny relative tolerance of a whole number of steps from start, so
+// Range(0, 0.3, 0.1) ends with exactly 0.3 despite 0.1 having no exact binary representation.
+func Range[Element Number](start Element, stop Element, step Element) []Element {
+	if step == 0 || !isFinite(step) {
+		panic("unexpected value for step parameter")
+	}
+	if !isFinite(start) {
+		panic("unexpected value for start parameter")
+	}
+	if !isFinite(stop) {
+		panic("unexpected value for stop parameter")
+	}
+	var count uint64
+	reachesStop := false
+	if isInteger[Element]() {
+		count = rangeCountInteger(start, stop, step)
 	} else {
-		panic("unexpected value for seed parameter")
+		count, reachesStop = rangeCountFloat(float64(start), float64(stop), float64(step))
+	}
+	numbers := make([]Element, count)
+	for index := range numbers {
+		numbers[index] = start + Element(index)*step
+	}
+	if reachesStop {
+		numbers[len(numbers)-1] = stop
 	}
-	return elements[rand.Intn(len(elements))]
+	return numbers
 }
 
 // Reduce invokes fun on each element in the slice with the accumulator.
@@ -255,38 +786,108 @@ func ReduceWhile[Element any, Accumulator any](elements []Element, fun func(Elem
 
 // Reject returns elements excluding those where fun returns true.
 func Reject[Element any](elements []Element, fun func(Element) bool) []Element {
-	return Reduce(elements, func(element Element, accumulator []Element) []Element {
-		if !fun(element) {
-			return append(accumulator, element)
-		}
-		return accumulator
-	}, make([]Element, 0))
+	return Filter(elements, func(element Element) bool {
+		return !fun(element)
+	})
+}
+
+// RejectInPlace removes the elements where fun returns true by moving the rest to the front of elements,
+// returning the shortened slice without allocating. The original contents of elements are overwritten.
+func RejectInPlace[Element any](elements []Element, fun func(Element) bool) []Element {
+	return FilterInPlace(elements, func(element Element) bool {
+		return !fun(element)
+	})
+}
+
+// Repeatedly invokes fun amount times and returns the results.
+func Repeatedly[Element any](amount uint, fun func() Element) []Element {
+	repeated := make([]Element, 0, amount)
+	for index := uint(0); index < amount; index++ {
+		repeated = append(repeated, fun())
+	}
+	return repeated
 }
 
 // Reverse returns a slice of elements in reverse order.
-func Reverse[Element comparable](elements []Element) []Element {
-	return Reduce(elements, func(element Element, accumulator []Element) []Element {
-		return append([]Element{element}, accumulator...)
-	}, make([]Element, 0))
+func Reverse[Element any](elements []Element) []Element {
+	reversed := make([]Element, len(elements))
+	for index, element := range elements {
+		reversed[len(elements)-1-index] = element
+	}
+	return reversed
+}
+
+// RunningMax returns the maximum element seen so far at each position in the slice.
+func RunningMax[Element constraints.Ordered](elements []Element) []Element {
+	if len(elements) == 0 {
+		return make([]Element, 0)
+	}
+	return Scan(elements, func(element Element, max Element) Element {
+		if element > max {
+			return element
+		}
+		return max
+	}, elements[0])
+}
+
+// RunningMin returns the minimum element seen so far at each position in the slice.
+func RunningMin[Element constraints.Ordered](elements []Element) []Element {
+	if len(elements) == 0 {
+		return make([]Element, 0)
+	}
+	return Scan(elements, func(element Element, min Element) Element {
+		if element < min {
+			return element
+		}
+		return min
+	}, elements[0])
+}
+
+// Scan invokes fun on each element in the slice with the accumulator and returns every intermediate accumulator.
+func Scan[Element any, Accumulator any](elements []Element, fun func(Element, Accumulator) Accumulator, accumulator Accumulator) []Accumulator {
+	return Reduce(elements, func(element Element, accumulators []Accumulator) []Accumulator {
+		accumulator = fun(element, accumulator)
+		return append(accumulators, accumulator)
+	}, make([]Accumulator, 0, len(elements)))
 }
 
 // Shuffle returns a list with the elements of the slice shuffled.
+//
+// Without a seed it draws from a package generator seeded from the clock once, leaving the global math/rand
+// generator untouched. With a seed it uses a private generator, so it is equivalent to ShuffleFrom with
+// rand.New(rand.NewSource(seed)).
 func Shuffle[Element any](elements []Element, seed ...int64) []Element {
-	if len(seed) == 0 {
-		rand.Seed(time.Now().UTC().UnixNano())
-	} else if len(seed) == 1 {
-		rand.Seed(seed[0])
-	} else {
-		panic("unexpected value for seed parameter")
-	}
+	return ShuffleFrom(elements, seededSource(seed))
+}
+
+// ShuffleFrom returns a list with the elements of the slice shuffled using source.
+//
+// If source has its own Shuffle method, as *rand.Rand does, it is used, so the order matches Shuffle
+// given the same seed.
+func ShuffleFrom[Element any](elements []Element, source RandomSource) []Element {
 	shuffledElements := make([]Element, len(elements))
 	copy(shuffledElements, elements)
-	rand.Shuffle(len(elements), func(i, j int) {
+	shuffle(source, len(shuffledElements), func(i, j int) {
 		shuffledElements[i], shuffledElements[j] = shuffledElements[j], shuffledElements[i]
 	})
 	return shuffledElements
 }
 
+// Slice returns amount elements starting at start (zero-based), or fewer if the slice ends first.
+// A negative start counts back from the end of the slice, and an out of range start returns an empty slice.
+func Slice[Element any](elements []Element, start int, amount uint) []Element {
+	if start < 0 {
+		start += len(elements)
+	}
+	if start < 0 || start >= len(elements) {
+		return make([]Element, 0)
+	}
+	if remaining := uint(len(elements) - start); amount > remaining {
+		amount = remaining
+	}
+	return Take(elements[start:], amount)
+}
+
 // Sort returns a slice sorted according to fun.
 func Sort[Element constraints.Ordered](elements []Element, order Order) []Element {
 	return SortBy(elements, func(element Element) Element {
@@ -294,15 +895,42 @@ func Sort[Element constraints.Ordered](elements []Element, order Order) []Elemen
 	}, order)
 }
 
-// SortBy returns a slice sorted according to fun.
+// SortBy returns a slice sorted according to fun. The sort is stable, elements with equal keys keep their original order.
+// fun is invoked exactly once per element, before any sorting takes place.
 func SortBy[Element any, SortBy constraints.Ordered](elements []Element, fun func(Element) SortBy, order Order) []Element {
-	sortedElements := make([]Element, len(elements))
-	copy(sortedElements, elements)
-	sort.Slice(sortedElements, func(i, j int) bool {
+	keys := Map(elements, fun)
+	return sortIndices(elements, func(i, j int) bool {
 		if order == Asc {
-			return fun(sortedElements[i]) < fun(sortedElements[j])
+			return keys[i] < keys[j]
 		}
-		return fun(sortedElements[i]) > fun(sortedElements[j])
+		return keys[i] > keys[j]
+	})
+}
+
+// SortByKeys returns a slice sorted by each key in turn, with later keys breaking ties between earlier ones.
+// The sort is stable, elements equal on every key keep their original order. Each key's fun is invoked
+// exactly once per element, before any sorting takes place.
+func SortByKeys[Element any](elements []Element, keys ...SortKey[Element]) []Element {
+	comparisons := Map(keys, func(key SortKey[Element]) func(int, int) int {
+		return key.compareBy(elements)
+	})
+	return sortIndices(elements, func(i, j int) bool {
+		for _, compare := range comparisons {
+			if comparison := compare(i, j); comparison != 0 {
+				return comparison < 0
+			}
+		}
+		return false
+	})
+}
+
+// SortWith returns a slice sorted according to compare, see MaxWith. The sort is stable, elements that compare
+// equal keep their original order.
+func SortWith[Element any](elements []Element, compare func(Element, Element) int) []Element {
+	sortedElements := make([]Element, len(elements))
+	copy(sortedElements, elements)
+	sort.SliceStable(sortedElements, func(i, j int) bool {
+		return compare(sortedElements[i], sortedElements[j]) < 0
 	})
 	return sortedElements
 }
@@ -310,53 +938,53 @@ func SortBy[Element any, SortBy constraints.Ordered](elements []Element, fun fun
 // SplitWhile splits the slice in two at the position of the element for which fun returns a false for the first time.
 func SplitWhile[Element any](elements []Element, fun func(Element) bool) ([]Element, []Element) {
 	addToLeft := true
-	result := Reduce(elements, func(element Element, accumulator pair[[]Element]) pair[[]Element] {
+	result := Reduce(elements, func(element Element, accumulator Pair[[]Element, []Element]) Pair[[]Element, []Element] {
 
 		if addToLeft == true && fun(element) == false {
 			addToLeft = false
 		}
 
 		if addToLeft {
-			accumulator.left = append(accumulator.left, element)
+			accumulator.First = append(accumulator.First, element)
 		} else {
-			accumulator.right = append(accumulator.right, element)
+			accumulator.Second = append(accumulator.Second, element)
 		}
 		return accumulator
-	}, pair[[]Element]{})
-	return result.left, result.right
+	}, Pair[[]Element, []Element]{})
+	return result.First, result.Second
 }
 
 // SplitWith splits the slice in two lists according to the given function fun.
 func SplitWith[Element any](elements []Element, fun func(Element) bool) ([]Element, []Element) {
-	result := Reduce(elements, func(element Element, accumulator pair[[]Element]) pair[[]Element] {
+	result := Reduce(elements, func(element Element, accumulator Pair[[]Element, []Element]) Pair[[]Element, []Element] {
 		if fun(element) {
-			accumulator.left = append(accumulator.left, element)
+			accumulator.First = append(accumulator.First, element)
 		} else {
-			accumulator.right = append(accumulator.right, element)
+			accumulator.Second = append(accumulator.Second, element)
 		}
 		return accumulator
-	}, pair[[]Element]{})
-	return result.left, result.right
+	}, Pair[[]Element, []Element]{})
+	return result.First, result.Second
 }
 
-// Sum returns the sum of all elements.
+// Sum returns the sum of all elements, or 0 if the slice is empty.
 func Sum[Element Number](elements []Element) Element {
 	return SumBy(elements, func(element Element) Element {
 		return element
 	})
 }
 
-// SumBy returns the sum of all elements according to fun.
+// SumBy returns the sum of all elements according to fun, or 0 if the slice is empty.
 func SumBy[Element any, SumBy Number](elements []Element, fun func(Element) SumBy) SumBy {
-	return Reduce(elements[1:], func(element Element, accumulator SumBy) SumBy {
+	return Reduce(elements, func(element Element, accumulator SumBy) SumBy {
 		return fun(element) + accumulator
-	}, fun(elements[0]))
+	}, 0)
 }
 
 // Take takes an amount of elements from the beginning of the slice.
 func Take[Element any](elements []Element, amount uint) []Element {
 	return ReduceWhile(elements, func(element Element, accumulator []Element) (Reduction, []Element) {
-		if len(accumulator) < int(amount) {
+		if uint(len(accumulator)) < amount {
 			accumulator = append(accumulator, element)
 			return Cont, accumulator
 		}
@@ -364,6 +992,24 @@ func Take[Element any](elements []Element, amount uint) []Element {
 	}, make([]Element, 0))
 }
 
+// TakeEvery returns a slice of every nth element, starting with the first element.
+// If nth is zero an empty slice is returned.
+func TakeEvery[Element any](elements []Element, nth uint) []Element {
+	index := uint(0)
+	return Filter(elements, func(element Element) bool {
+		index++
+		return nth != 0 && (index-1)%nth == 0
+	})
+}
+
+// TakeLast takes an amount of elements from the end of the slice.
+func TakeLast[Element any](elements []Element, amount uint) []Element {
+	if amount > uint(len(elements)) {
+		amount = uint(len(elements))
+	}
+	return Drop(elements, uint(len(elements))-amount)
+}
+
 // TakeWhile takes the elements from the beginning of the slice while fun returns a truthy value.
 func TakeWhile[Element any](elements []Element, fun func(Element) bool) []Element {
 	return ReduceWhile(elements, func(element Element, accumulator []Element) (Reduction, []Element) {
@@ -374,22 +1020,212 @@ func TakeWhile[Element any](elements []Element, fun func(Element) bool) []Elemen
 	}, make([]Element, 0))
 }
 
-// Uniq iterates over the slice, removing all duplicated elements.
+// Unfold builds a slice by invoking fun on the accumulator, which returns the next element,
+// the next accumulator and true, or false once there are no more elements.
+func Unfold[Element any, Accumulator any](accumulator Accumulator, fun func(Accumulator) (Element, Accumulator, bool)) []Element {
+	unfolded := make([]Element, 0)
+	for {
+		element, next, ok := fun(accumulator)
+		if !ok {
+			return unfolded
+		}
+		unfolded = append(unfolded, element)
+		accumulator = next
+	}
+}
+
+// Uniq iterates over the slice, removing all duplicated elements. The first occurrence of each element is kept.
 func Uniq[Element comparable](elements []Element) []Element {
+	return UniqBy(elements, func(element Element) Element {
+		return element
+	})
+}
+
+// UniqBy iterates over the slice, removing all duplicated elements according to fun. The first occurrence of each
+// key is kept.
+func UniqBy[Element any, UniqBy comparable](elements []Element, fun func(Element) UniqBy) []Element {
+	seen := make(map[UniqBy]struct{})
 	return Reduce(elements, func(element Element, accumulator []Element) []Element {
-		if !IsMember(accumulator, element) {
+		key := fun(element)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
 			accumulator = append(accumulator, element)
 		}
 		return accumulator
 	}, make([]Element, 0))
 }
 
-// UniqBy iterates over the slice, removing all duplicated elements according to fun.
-func UniqBy[Element any, UniqBy comparable](elements []Element, fun func(Element) UniqBy) []Element {
+// UniqWith iterates over the slice, removing all elements that compare equal to an earlier element according to compare,
+// see MaxWith. Without a hashable key every element is compared against each unique element kept so far.
+func UniqWith[Element any](elements []Element, compare func(Element, Element) int) []Element {
 	return Reduce(elements, func(element Element, accumulator []Element) []Element {
-		if !IsMemberBy(accumulator, element, fun) {
+		if !Any(accumulator, func(kept Element) bool {
+			return compare(kept, element) == 0
+		}) {
 			accumulator = append(accumulator, element)
 		}
 		return accumulator
 	}, make([]Element, 0))
 }
+
+// Unzip splits a slice of pairs into a slice of first values and a slice of second values.
+func Unzip[First any, Second any](pairs []Pair[First, Second]) ([]First, []Second) {
+	firsts := make([]First, 0, len(pairs))
+	seconds := make([]Second, 0, len(pairs))
+	Each(pairs, func(pair Pair[First, Second]) {
+		firsts = append(firsts, pair.First)
+		seconds = append(seconds, pair.Second)
+	})
+	return firsts, seconds
+}
+
+// Unzip3 splits a slice of triples into three slices of first, second and third values.
+func Unzip3[First any, Second any, Third any](triples []Triple[First, Second, Third]) ([]First, []Second, []Third) {
+	firsts := make([]First, 0, len(triples))
+	seconds := make([]Second, 0, len(triples))
+	thirds := make([]Third, 0, len(triples))
+	Each(triples, func(triple Triple[First, Second, Third]) {
+		firsts = append(firsts, triple.First)
+		seconds = append(seconds, triple.Second)
+		thirds = append(thirds, triple.Third)
+	})
+	return firsts, seconds, thirds
+}
+
+// Zip zips corresponding elements from two slices into a slice of pairs, stopping at the shortest slice.
+func Zip[First any, Second any](first []First, second []Second) []Pair[First, Second] {
+	return ZipWith(first, second, func(first First, second Second) Pair[First, Second] {
+		return Pair[First, Second]{First: first, Second: second}
+	})
+}
+
+// Zip3 zips corresponding elements from three slices into a slice of triples, stopping at the shortest slice.
+func Zip3[First any, Second any, Third any](first []First, second []Second, third []Third) []Triple[First, Second, Third] {
+	length := Min([]int{len(first), len(second), len(third)})
+	triples := make([]Triple[First, Second, Third], length)
+	for index := range triples {
+		triples[index] = Triple[First, Second, Third]{First: first[index], Second: second[index], Third: third[index]}
+	}
+	return triples
+}
+
+// ZipLongest zips corresponding elements from two slices into a slice of pairs, padding the shortest slice
+// with firstDefault or secondDefault until the longest slice is exhausted.
+func ZipLongest[First any, Second any](first []First, second []Second, firstDefault First, secondDefault Second) []Pair[First, Second] {
+	pairs := make([]Pair[First, Second], Max([]int{len(first), len(second)}))
+	for index := range pairs {
+		pairs[index] = Pair[First, Second]{First: At(first, index, firstDefault), Second: At(second, index, secondDefault)}
+	}
+	return pairs
+}
+
+// ZipReduce reduces over two slices in lockstep with the accumulator, stopping at the shortest slice.
+func ZipReduce[First any, Second any, Accumulator any](first []First, second []Second, fun func(First, Second, Accumulator) Accumulator, accumulator Accumulator) Accumulator {
+	return Reduce(Zip(first, second), func(pair Pair[First, Second], accumulator Accumulator) Accumulator {
+		return fun(pair.First, pair.Second, accumulator)
+	}, accumulator)
+}
+
+// ZipWith invokes fun on corresponding elements from two slices, stopping at the shortest slice.
+func ZipWith[First any, Second any, ReturnElement any](first []First, second []Second, fun func(First, Second) ReturnElement) []ReturnElement {
+	zipped := make([]ReturnElement, Min([]int{len(first), len(second)}))
+	for index := range zipped {
+		zipped[index] = fun(first[index], second[index])
+	}
+	return zipped
+}
+
+// chunkEvery implements ChunkEvery and ChunkEveryDiscard, discard dropping the incomplete last chunk.
+func chunkEvery[Element any](elements []Element, count uint, step uint, leftover []Element, discard bool) [][]Element {
+	if count == 0 {
+		panic("unexpected value for count parameter")
+	}
+	if step == 0 {
+		panic("unexpected value for step parameter")
+	}
+	chunks := make([][]Element, 0)
+	for start := 0; start < len(elements); start += int(step) {
+		end := start + int(count)
+		chunk := make([]Element, 0, count)
+		if end <= len(elements) {
+			chunks = append(chunks, append(chunk, elements[start:end]...))
+			continue
+		}
+		if !discard {
+			chunk = append(chunk, elements[start:]...)
+			chunks = append(chunks, append(chunk, Take(leftover, uint(end-len(elements)))...))
+		}
+		break
+	}
+	return chunks
+}
+
+// isFinite reports whether number is neither NaN nor infinite, which every integer is.
+func isFinite[Element Number](number Element) bool {
+	return isInteger[Element]() || !(math.IsNaN(float64(number)) || math.IsInf(float64(number), 0))
+}
+
+// rangeCountFloat returns how many numbers Range produces for a float range, treating a number of steps
+// within a relative tolerance of a whole number as that whole number, and whether the last number is stop.
+func rangeCountFloat(start float64, stop float64, step float64) (uint64, bool) {
+	steps := (stop - start) / step
+	rounded := math.Round(steps)
+	reachesStop := math.Abs(steps-rounded) <= 1e-9*math.Max(1, math.Abs(rounded))
+	if reachesStop {
+		steps = rounded
+	}
+	if steps < 0 {
+		return 0, false
+	}
+	return uint64(math.Floor(steps)) + 1, reachesStop
+}
+
+// rangeCountInteger returns how many numbers Range produces for an integer range. The distance is taken
+// in uint64, where wrapping subtraction gives the exact distance even when it overflows Element.
+func rangeCountInteger[Element Number](start Element, stop Element, step Element) uint64 {
+	if (step > 0 && stop < start) || (step < 0 && stop > start) {
+		return 0
+	}
+	distance, size := uint64(stop)-uint64(start), uint64(step)
+	if step < 0 {
+		distance, size = uint64(start)-uint64(stop), -uint64(step)
+	}
+	return distance/size + 1
+}
+
+// seededSource returns a new generator seeded with the optional seed parameter, or defaultRandomSource if it is omitted.
+func seededSource(seed []int64) RandomSource {
+	if len(seed) == 0 {
+		return defaultRandomSource
+	} else if len(seed) == 1 {
+		return rand.New(rand.NewSource(seed[0]))
+	}
+	panic("unexpected value for seed parameter")
+}
+
+// shuffle shuffles n elements with swap, using source's own Shuffle method if it has one
+// and a Fisher-Yates shuffle otherwise.
+func shuffle(source RandomSource, n int, swap func(i, j int)) {
+	if shuffler, ok := source.(shuffler); ok {
+		shuffler.Shuffle(n, swap)
+		return
+	}
+	for i := n - 1; i > 0; i-- {
+		swap(i, source.Intn(i+1))
+	}
+}
+
+// sortIndices returns a copy of elements stably sorted by less, which compares the elements at two indices
+// of the original slice. It lets callers sort on keys computed once up front rather than on every comparison.
+func sortIndices[Element any](elements []Element, less func(int, int) bool) []Element {
+	indices := make([]int, len(elements))
+	for index := range indices {
+		indices[index] = index
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return less(indices[i], indices[j])
+	})
+	return Map(indices, func(index int) Element {
+		return elements[index]
+	})
+}
@@ -1,11 +1,16 @@
 package slice_test
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/rand"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/nwjlyons/slice"
 )
@@ -21,6 +26,7 @@ func TestAll(t *testing.T) {
 	}
 	assertEqual(t, slice.All([]int{1, 2, 4, 6, 8}, isEven), false)
 	assertEqual(t, slice.All([]int{2, 4, 6, 8}, isEven), true)
+	assertEqual(t, slice.All([]int{}, isEven), true)
 }
 
 func TestAny(t *testing.T) {
@@ -31,15 +37,172 @@ func TestAny(t *testing.T) {
 	assertEqual(t, slice.Any([]int{1, 3, 2, 7, 9}, isEven), true)
 }
 
+func TestAppendFilter(t *testing.T) {
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	dst := make([]int, 0, 8)
+	dst = slice.AppendFilter(dst, []int{1, 2, 3, 4}, isEven)
+	dst = slice.AppendFilter(dst, []int{5, 6}, isEven)
+	assertEqual(t, dst, []int{2, 4, 6})
+	assertEqual(t, slice.AppendFilter([]int{0}, []int{1, 3}, isEven), []int{0})
+
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = slice.AppendFilter(dst[:0], numbers, isEven)
+	})
+	assertEqual(t, allocs, 0.0)
+}
+
+func TestAppendMap(t *testing.T) {
+	dst := slice.AppendMap([]string{"0"}, []int{1, 2, 3}, strconv.Itoa)
+	assertEqual(t, dst, []string{"0", "1", "2", "3"})
+
+	numbers := []int{1, 2, 3, 4}
+	squares := make([]int, 0, len(numbers))
+	allocs := testing.AllocsPerRun(100, func() {
+		squares = slice.AppendMap(squares[:0], numbers, func(number int) int {
+			return number * number
+		})
+	})
+	assertEqual(t, allocs, 0.0)
+	assertEqual(t, squares, []int{1, 4, 9, 16})
+}
+
 func TestAt(t *testing.T) {
 	colours := []string{"Cyan", "Magenta", "Yellow"}
 	assertEqual(t, slice.At(colours, 1, "Black"), "Magenta")
 	assertEqual(t, slice.At(colours, 10, "Black"), "Black")
+	assertEqual(t, slice.At(colours, -1, "Black"), "Yellow")
+	assertEqual(t, slice.At(colours, -3, "Black"), "Cyan")
+	assertEqual(t, slice.At(colours, -4, "Black"), "Black")
+}
+
+func TestBinarySearch(t *testing.T) {
+	numbers := []int{1, 3, 5, 5, 7}
+	index, found := slice.BinarySearch(numbers, 5, slice.Asc)
+	assertEqual(t, index, 2)
+	assertEqual(t, found, true)
+	index, found = slice.BinarySearch(numbers, 4, slice.Asc)
+	assertEqual(t, index, 2)
+	assertEqual(t, found, false)
+	index, found = slice.BinarySearch(numbers, 8, slice.Asc)
+	assertEqual(t, index, 5)
+	assertEqual(t, found, false)
+
+	descending := slice.Sort(numbers, slice.Desc)
+	index, found = slice.BinarySearch(descending, 3, slice.Desc)
+	assertEqual(t, index, 3)
+	assertEqual(t, found, true)
+	index, found = slice.BinarySearch(descending, 6, slice.Desc)
+	assertEqual(t, index, 1)
+	assertEqual(t, found, false)
+
+	index, found = slice.BinarySearch([]int{}, 1, slice.Asc)
+	assertEqual(t, index, 0)
+	assertEqual(t, found, false)
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	neptune := planet{Name: "Neptune", Radius: 24_622_000}
+	mars := planet{Name: "Mars", Radius: 3_389_500}
+	jupiter := planet{Name: "Jupiter", Radius: 69_911_000}
+	radius := func(planet planet) int {
+		return planet.Radius
+	}
+	planets := slice.SortBy([]planet{neptune, mars, jupiter}, radius, slice.Asc)
+
+	index, found := slice.BinarySearchBy(planets, neptune.Radius, radius, slice.Asc)
+	assertEqual(t, index, 1)
+	assertEqual(t, found, true)
+	index, found = slice.BinarySearchBy(planets, 1, radius, slice.Asc)
+	assertEqual(t, index, 0)
+	assertEqual(t, found, false)
+}
+
+func TestBy(t *testing.T) {
+	short, long := "Mars", "Jupiter"
+	assertEqual(t, slice.SortByKeys([]string{long, short}, slice.By(func(name string) int {
+		return len(name)
+	}, slice.Asc)), []string{short, long})
+	assertEqual(t, slice.SortByKeys([]string{short, long}, slice.By(func(name string) int {
+		return len(name)
+	}, slice.Desc)), []string{long, short})
+}
+
+func TestChunkBy(t *testing.T) {
+	numbers := []int{1, 2, 2, 3, 4, 4, 6, 7, 7}
+	got := slice.ChunkBy(numbers, func(number int) bool {
+		return number%2 == 1
+	})
+	assertEqual(t, got, [][]int{{1}, {2, 2}, {3}, {4, 4, 6}, {7, 7}})
+	assertEqual(t, slice.ChunkBy([]int{}, func(number int) int { return number }), [][]int{})
+}
+
+func TestChunkEvery(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6}
+	assertEqual(t, slice.ChunkEvery(numbers, 2, 2, []int{}), [][]int{{1, 2}, {3, 4}, {5, 6}})
+	assertEqual(t, slice.ChunkEvery(numbers, 3, 2, nil), [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6}})
+	assertEqual(t, slice.ChunkEvery(numbers, 3, 2, []int{7}), [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6, 7}})
+	assertEqual(t, slice.ChunkEvery([]int{1, 2, 3, 4}, 3, 3, []int{}), [][]int{{1, 2, 3}, {4}})
+	assertEqual(t, slice.ChunkEvery([]int{1, 2, 3, 4}, 10, 10, []int{}), [][]int{{1, 2, 3, 4}})
+	assertEqual(t, slice.ChunkEvery([]int{1, 2, 3, 4, 5}, 2, 3, []int{}), [][]int{{1, 2}, {4, 5}})
+	assertEqual(t, slice.ChunkEvery([]int{1, 2, 3, 4}, 2, 1, []int{}), [][]int{{1, 2}, {2, 3}, {3, 4}, {4}})
+
+	chunks := slice.ChunkEvery(numbers, 2, 2, nil)
+	chunks[0][0] = 42
+	assertEqual(t, numbers[0], 1)
+}
+
+func TestChunkEveryDiscard(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6}
+	assertEqual(t, slice.ChunkEveryDiscard(numbers, 2, 2), [][]int{{1, 2}, {3, 4}, {5, 6}})
+	assertEqual(t, slice.ChunkEveryDiscard(numbers, 3, 2), [][]int{{1, 2, 3}, {3, 4, 5}})
+	assertEqual(t, slice.ChunkEveryDiscard([]int{1, 2}, 3, 3), [][]int{})
+}
+
+func TestChunkWhile(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	chunkFun := func(number int, accumulator []int) (slice.Reduction, []int, []int, bool) {
+		if number%2 == 0 {
+			return slice.Cont, []int{}, append(accumulator, number), true
+		}
+		return slice.Cont, append(accumulator, number), nil, false
+	}
+	afterFun := func(accumulator []int) ([]int, bool) {
+		return accumulator, len(accumulator) > 0
+	}
+	assertEqual(t, slice.ChunkWhile(numbers, chunkFun, afterFun, []int{}), [][]int{{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10}})
+	assertEqual(t, slice.ChunkWhile(numbers[:5], chunkFun, afterFun, []int{}), [][]int{{1, 2}, {3, 4}, {5}})
+
+	sums := slice.ChunkWhile(numbers, func(number int, total int) (slice.Reduction, int, int, bool) {
+		if number > 7 {
+			return slice.Halt, total, 0, false
+		}
+		if total+number > 5 {
+			return slice.Cont, number, total, true
+		}
+		return slice.Cont, total + number, 0, false
+	}, func(total int) (int, bool) {
+		return total, true
+	}, 0)
+	assertEqual(t, sums, []int{3, 3, 4, 5, 6, 7})
 }
 
 func TestConcat(t *testing.T) {
 	colours := []string{"Cyan", "Magenta", "Yellow", "Black"}
 	assertEqual(t, slice.Concat([]string{"Cyan", "Magenta"}, []string{"Yellow", "Black"}), colours)
+
+	base := make([]string, 1, 4)
+	first := slice.Concat(base, []string{"Cyan"})
+	second := slice.Concat(base, []string{"Magenta"})
+	assertEqual(t, first, []string{"", "Cyan"})
+	assertEqual(t, second, []string{"", "Magenta"})
+}
+
+func TestConcatAll(t *testing.T) {
+	assertEqual(t, slice.ConcatAll([]int{1}, []int{}, []int{2, 3}), []int{1, 2, 3})
+	assertEqual(t, slice.ConcatAll[int](), []int{})
 }
 
 func TestCount(t *testing.T) {
@@ -55,11 +218,87 @@ func TestCountBy(t *testing.T) {
 	assertEqual(t, slice.CountBy(numbers, isEven), 4)
 }
 
+func TestCumulativeProduct(t *testing.T) {
+	assertEqual(t, slice.CumulativeProduct([]int{1, 2, 3, 4}), []int{1, 2, 6, 24})
+	assertEqual(t, slice.CumulativeProduct([]float64{0.5, 4}), []float64{0.5, 2})
+	assertEqual(t, slice.CumulativeProduct([]int{}), []int{})
+}
+
+func TestCumulativeSum(t *testing.T) {
+	assertEqual(t, slice.CumulativeSum([]int{1, 2, 3, 4}), []int{1, 3, 6, 10})
+	assertEqual(t, slice.CumulativeSum([]float64{0.5, 1.5}), []float64{0.5, 2})
+	assertEqual(t, slice.CumulativeSum([]int{}), []int{})
+}
+
+func TestCycle(t *testing.T) {
+	assertEqual(t, slice.Cycle([]int{1, 2, 3}, 7), []int{1, 2, 3, 1, 2, 3, 1})
+	assertEqual(t, slice.Cycle([]int{1, 2, 3}, 0), []int{})
+	assertEqual(t, slice.Cycle([]int{}, 3), []int{})
+}
+
+func TestDrop(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars"}
+	assertEqual(t, slice.Drop(planets, 2), []string{"Earth", "Mars"})
+	assertEqual(t, slice.Drop(planets, 0), planets)
+	assertEqual(t, slice.Drop(planets, 10), []string{})
+}
+
+func TestDropEvery(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assertEqual(t, slice.DropEvery(numbers, 2), []int{2, 4, 6, 8, 10})
+	assertEqual(t, slice.DropEvery(numbers, 3), []int{2, 3, 5, 6, 8, 9})
+	assertEqual(t, slice.DropEvery(numbers, 0), numbers)
+	assertEqual(t, slice.DropEvery(numbers, 1), []int{})
+}
+
+func TestDropLast(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars"}
+	assertEqual(t, slice.DropLast(planets, 1), []string{"Mercury", "Venus", "Earth"})
+	assertEqual(t, slice.DropLast(planets, 10), []string{})
+}
+
+func TestDropWhile(t *testing.T) {
+	numbers := []int{1, 2, 3, 2, 1}
+	assertEqual(t, slice.DropWhile(numbers, func(number int) bool {
+		return number < 3
+	}), []int{3, 2, 1})
+	assertEqual(t, slice.DropWhile(numbers, func(number int) bool {
+		return true
+	}), []int{})
+}
+
 func TestEach(t *testing.T) {
 	countdown := []string{"3", "2", "1", "Go!"}
 	slice.Each(countdown, func(tick string) { fmt.Println(tick) })
 }
 
+func TestFetch(t *testing.T) {
+	colours := []string{"Cyan", "Magenta", "Yellow"}
+	colour, ok := slice.Fetch(colours, 1)
+	assertEqual(t, colour, "Magenta")
+	assertEqual(t, ok, true)
+	colour, ok = slice.Fetch(colours, -1)
+	assertEqual(t, colour, "Yellow")
+	assertEqual(t, ok, true)
+	colour, ok = slice.Fetch(colours, 3)
+	assertEqual(t, colour, "")
+	assertEqual(t, ok, false)
+	_, ok = slice.Fetch(colours, -4)
+	assertEqual(t, ok, false)
+	_, ok = slice.Fetch([]string{"", "Key"}, 0)
+	assertEqual(t, ok, true)
+}
+
+func TestFetchOrError(t *testing.T) {
+	colours := []string{"Cyan", "Magenta", "Yellow"}
+	colour, err := slice.FetchOrError(colours, -2)
+	assertEqual(t, colour, "Magenta")
+	assertEqual(t, err, nil)
+	_, err = slice.FetchOrError(colours, 5)
+	assertEqual(t, errors.Is(err, slice.ErrOutOfRange), true)
+	assertEqual(t, err.Error(), "slice: index out of range: index 5 with length 3")
+}
+
 func TestFilter(t *testing.T) {
 	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
 	got := slice.Filter(numbers, func(number int) bool {
@@ -68,6 +307,77 @@ func TestFilter(t *testing.T) {
 	assertEqual(t, got, []int{2, 4, 6, 8})
 }
 
+func TestFilterInPlace(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	got := slice.FilterInPlace(numbers, func(number int) bool {
+		return number%2 == 0
+	})
+	assertEqual(t, got, []int{2, 4, 6, 8})
+	assertEqual(t, numbers, []int{2, 4, 6, 8, 0, 0, 0, 0, 0})
+	assertEqual(t, slice.FilterInPlace([]int{}, func(number int) bool { return true }), []int{})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		slice.FilterInPlace(numbers, func(number int) bool {
+			return number > 0
+		})
+	})
+	assertEqual(t, allocs, 0.0)
+}
+
+func TestFind(t *testing.T) {
+	numbers := []int{1, 3, 4, 5, 6}
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	number, found := slice.Find(numbers, isEven)
+	assertEqual(t, number, 4)
+	assertEqual(t, found, true)
+	number, found = slice.Find([]int{1, 3}, isEven)
+	assertEqual(t, number, 0)
+	assertEqual(t, found, false)
+}
+
+func TestFindIndex(t *testing.T) {
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	assertEqual(t, slice.FindIndex([]int{1, 3, 4, 5, 6}, isEven), 2)
+	assertEqual(t, slice.FindIndex([]int{1, 3}, isEven), -1)
+}
+
+func TestFindLast(t *testing.T) {
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	number, found := slice.FindLast([]int{1, 3, 4, 5, 6, 7}, isEven)
+	assertEqual(t, number, 6)
+	assertEqual(t, found, true)
+	_, found = slice.FindLast([]int{}, isEven)
+	assertEqual(t, found, false)
+}
+
+func TestFindLastIndex(t *testing.T) {
+	isEven := func(number int) bool {
+		return number%2 == 0
+	}
+	assertEqual(t, slice.FindLastIndex([]int{1, 3, 4, 5, 6, 7}, isEven), 4)
+	assertEqual(t, slice.FindLastIndex([]int{1, 3}, isEven), -1)
+}
+
+func TestFindValue(t *testing.T) {
+	moons := map[string]string{"Earth": "Moon", "Mars": "Phobos"}
+	planets := []string{"Mercury", "Venus", "Mars", "Earth"}
+	moon, found := slice.FindValue(planets, func(planet string) string {
+		return moons[planet]
+	})
+	assertEqual(t, moon, "Phobos")
+	assertEqual(t, found, true)
+	_, found = slice.FindValue(planets[:2], func(planet string) string {
+		return moons[planet]
+	})
+	assertEqual(t, found, false)
+}
+
 func TestFlatMap(t *testing.T) {
 	numbers := []int{1, 2, 3}
 	assertEqual(t, slice.FlatMap(numbers, func(number int) []int {
@@ -96,6 +406,12 @@ func TestGroupBy(t *testing.T) {
 		return len(planet)
 	})
 	assertEqual(t, got, expected)
+
+	length, calls := countCalls(func(planet string) int {
+		return len(planet)
+	})
+	slice.GroupBy(planets, length)
+	assertEqual(t, *calls, len(planets))
 }
 
 func TestIsMember(t *testing.T) {
@@ -116,6 +432,31 @@ func TestIsMemberBy(t *testing.T) {
 	}), true)
 }
 
+func TestIterate(t *testing.T) {
+	double := func(number int) int {
+		return number * 2
+	}
+	assertEqual(t, slice.Iterate(1, double, 5), []int{1, 2, 4, 8, 16})
+	assertEqual(t, slice.Iterate(1, double, 0), []int{})
+}
+
+func TestLockedRandomSource(t *testing.T) {
+	source := slice.LockedRandomSource(rand.New(rand.NewSource(42)))
+	planets := []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				slice.RandomFrom(planets, source)
+				slice.ShuffleFrom(planets, source)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func TestMap(t *testing.T) {
 	trafficLights := []string{"red", "amber", "green"}
 	got := slice.Map(trafficLights, func(light string) string {
@@ -146,6 +487,55 @@ func TestMaxBy(t *testing.T) {
 		return planet.Radius
 	})
 	assertEqual(t, maxPlanet.Name, jupiter.Name)
+
+	radius, calls := countCalls(func(planet planet) int {
+		return planet.Radius
+	})
+	slice.MaxBy(planets, radius)
+	assertEqual(t, *calls, len(planets))
+}
+
+func TestMaxByOK(t *testing.T) {
+	mars := planet{Name: "Mars", Radius: 3_389_500}
+	jupiter := planet{Name: "Jupiter", Radius: 69_911_000}
+	radius := func(planet planet) int {
+		return planet.Radius
+	}
+	maxPlanet, ok := slice.MaxByOK([]planet{mars, jupiter}, radius)
+	assertEqual(t, maxPlanet, jupiter)
+	assertEqual(t, ok, true)
+	maxPlanet, ok = slice.MaxByOK([]planet{}, radius)
+	assertEqual(t, maxPlanet, planet{})
+	assertEqual(t, ok, false)
+}
+
+func TestMaxByOr(t *testing.T) {
+	mars := planet{Name: "Mars", Radius: 3_389_500}
+	jupiter := planet{Name: "Jupiter", Radius: 69_911_000}
+	radius := func(planet planet) int {
+		return planet.Radius
+	}
+	assertEqual(t, slice.MaxByOr([]planet{mars, jupiter}, radius, mars), jupiter)
+	assertEqual(t, slice.MaxByOr([]planet{}, radius, mars), mars)
+}
+
+func TestMaxOK(t *testing.T) {
+	max, ok := slice.MaxOK([]int{6, 4, 9, 2})
+	assertEqual(t, max, 9)
+	assertEqual(t, ok, true)
+	max, ok = slice.MaxOK([]int{})
+	assertEqual(t, max, 0)
+	assertEqual(t, ok, false)
+}
+
+func TestMaxOr(t *testing.T) {
+	assertEqual(t, slice.MaxOr([]int{6, 4, 9, 2}, -1), 9)
+	assertEqual(t, slice.MaxOr([]int{}, -1), -1)
+}
+
+func TestMaxWith(t *testing.T) {
+	launches := []time.Time{time.Date(1977, 9, 5, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 19, 0, 0, 0, 0, time.UTC), time.Date(1977, 8, 20, 0, 0, 0, 0, time.UTC)}
+	assertEqual(t, slice.MaxWith(launches, compareTimes), launches[1])
 }
 
 func TestMin(t *testing.T) {
@@ -163,6 +553,35 @@ func TestMinBy(t *testing.T) {
 		return planet.Radius
 	})
 	assertEqual(t, minPlanet.Name, mars.Name)
+
+	radius, calls := countCalls(func(planet planet) int {
+		return planet.Radius
+	})
+	slice.MinBy(planets, radius)
+	assertEqual(t, *calls, len(planets))
+}
+
+func TestMinByOK(t *testing.T) {
+	mars := planet{Name: "Mars", Radius: 3_389_500}
+	jupiter := planet{Name: "Jupiter", Radius: 69_911_000}
+	radius := func(planet planet) int {
+		return planet.Radius
+	}
+	minPlanet, ok := slice.MinByOK([]planet{jupiter, mars}, radius)
+	assertEqual(t, minPlanet, mars)
+	assertEqual(t, ok, true)
+	_, ok = slice.MinByOK([]planet{}, radius)
+	assertEqual(t, ok, false)
+}
+
+func TestMinByOr(t *testing.T) {
+	mars := planet{Name: "Mars", Radius: 3_389_500}
+	jupiter := planet{Name: "Jupiter", Radius: 69_911_000}
+	radius := func(planet planet) int {
+		return planet.Radius
+	}
+	assertEqual(t, slice.MinByOr([]planet{jupiter, mars}, radius, jupiter), mars)
+	assertEqual(t, slice.MinByOr([]planet{}, radius, jupiter), jupiter)
 }
 
 func TestMinMax(t *testing.T) {
@@ -183,12 +602,86 @@ func TestMinMaxBy(t *testing.T) {
 	})
 	assertEqual(t, minPlanet.Name, mars.Name)
 	assertEqual(t, maxPlanet.Name, jupiter.Name)
+
+	radius, calls := countCalls(func(planet planet) int {
+		return planet.Radius
+	})
+	slice.MinMaxBy(planets, radius)
+	assertEqual(t, *calls, len(planets))
+}
+
+func TestMinMaxByOK(t *testing.T) {
+	mars := planet{Name: "Mars", Radius: 3_389_500}
+	jupiter := planet{Name: "Jupiter", Radius: 69_911_000}
+	radius := func(planet planet) int {
+		return planet.Radius
+	}
+	minPlanet, maxPlanet, ok := slice.MinMaxByOK([]planet{jupiter, mars}, radius)
+	assertEqual(t, minPlanet, mars)
+	assertEqual(t, maxPlanet, jupiter)
+	assertEqual(t, ok, true)
+	_, _, ok = slice.MinMaxByOK([]planet{}, radius)
+	assertEqual(t, ok, false)
+}
+
+func TestMinMaxByOr(t *testing.T) {
+	mars := planet{Name: "Mars", Radius: 3_389_500}
+	radius := func(planet planet) int {
+		return planet.Radius
+	}
+	minPlanet, maxPlanet := slice.MinMaxByOr([]planet{}, radius, mars)
+	assertEqual(t, minPlanet, mars)
+	assertEqual(t, maxPlanet, mars)
+}
+
+func TestMinMaxOK(t *testing.T) {
+	min, max, ok := slice.MinMaxOK([]int{6, 4, 9, 2})
+	assertEqual(t, min, 2)
+	assertEqual(t, max, 9)
+	assertEqual(t, ok, true)
+	_, _, ok = slice.MinMaxOK([]int{})
+	assertEqual(t, ok, false)
+}
+
+func TestMinMaxOr(t *testing.T) {
+	min, max := slice.MinMaxOr([]int{}, 0)
+	assertEqual(t, min, 0)
+	assertEqual(t, max, 0)
+	min, max = slice.MinMaxOr([]int{3, 1, 2}, 0)
+	assertEqual(t, min, 1)
+	assertEqual(t, max, 3)
+}
+
+func TestMinMaxWith(t *testing.T) {
+	launches := []time.Time{time.Date(1977, 9, 5, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 19, 0, 0, 0, 0, time.UTC), time.Date(1977, 8, 20, 0, 0, 0, 0, time.UTC)}
+	first, last := slice.MinMaxWith(launches, compareTimes)
+	assertEqual(t, first, launches[2])
+	assertEqual(t, last, launches[1])
+}
+
+func TestMinOK(t *testing.T) {
+	min, ok := slice.MinOK([]int{6, 4, 9, 2})
+	assertEqual(t, min, 2)
+	assertEqual(t, ok, true)
+	_, ok = slice.MinOK([]int{})
+	assertEqual(t, ok, false)
+}
+
+func TestMinOr(t *testing.T) {
+	assertEqual(t, slice.MinOr([]int{6, 4, 9, 2}, -1), 2)
+	assertEqual(t, slice.MinOr([]int{}, -1), -1)
+}
+
+func TestMinWith(t *testing.T) {
+	launches := []time.Time{time.Date(1977, 9, 5, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 19, 0, 0, 0, 0, time.UTC), time.Date(1977, 8, 20, 0, 0, 0, 0, time.UTC)}
+	assertEqual(t, slice.MinWith(launches, compareTimes), launches[2])
 }
 
 func TestProduct(t *testing.T) {
 	assertEqual(t, slice.Product([]int{2, 3, 4}), 24)
 	assertEqual(t, slice.Product([]int{2.0, 3.0, 4.0}), 24.0)
 	assertEqual(t, slice.Product([]int{42}), 42)
+	assertEqual(t, slice.Product([]int{}), 1)
 }
 
 func TestProductBy(t *testing.T) {
@@ -205,6 +698,61 @@ func TestRandom(t *testing.T) {
 	assertEqual(t, slice.Random(planets, 42), "Venus")
 }
 
+func TestRandomFrom(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
+	assertEqual(t, slice.RandomFrom(planets, rand.New(rand.NewSource(42))), "Venus")
+
+	rand.Seed(7)
+	expected := rand.Int63()
+	rand.Seed(7)
+	slice.Random(planets, 42)
+	slice.RandomFrom(planets, rand.New(rand.NewSource(1)))
+	assertEqual(t, rand.Int63(), expected)
+}
+
+func TestRandomOK(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
+	planet, ok := slice.RandomOK(planets, 42)
+	assertEqual(t, planet, "Venus")
+	assertEqual(t, ok, true)
+	planet, ok = slice.RandomOK([]string{})
+	assertEqual(t, planet, "")
+	assertEqual(t, ok, false)
+}
+
+func TestRandomOr(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
+	assertEqual(t, slice.RandomOr(planets, "Pluto", 42), "Venus")
+	assertEqual(t, slice.RandomOr([]string{}, "Pluto"), "Pluto")
+}
+
+func TestRange(t *testing.T) {
+	assertEqual(t, slice.Range(1, 5, 1), []int{1, 2, 3, 4, 5})
+	assertEqual(t, slice.Range(1, 10, 3), []int{1, 4, 7, 10})
+	assertEqual(t, slice.Range(5, 1, -2), []int{5, 3, 1})
+	assertEqual(t, slice.Range(1, 5, -1), []int{})
+	assertEqual(t, slice.Range[uint](5, 1, 1), []uint{})
+	assertEqual(t, slice.Range[int8](125, 127, 1), []int8{125, 126, 127})
+	assertEqual(t, slice.Range[int8](-128, 127, 85), []int8{-128, -43, 42, 127})
+	assertEqual(t, slice.Range[int64](math.MaxInt64-2, math.MaxInt64, 1), []int64{math.MaxInt64 - 2, math.MaxInt64 - 1, math.MaxInt64})
+	assertEqual(t, slice.Range[int64](math.MinInt64+2, math.MinInt64, -1), []int64{math.MinInt64 + 2, math.MinInt64 + 1, math.MinInt64})
+	assertEqual(t, slice.Range[uint](10, 0, 5), []uint{})
+	assertEqual(t, slice.Range(0, 1, 0.25), []float64{0, 0.25, 0.5, 0.75, 1})
+	assertEqual(t, slice.Range(0, 0.3, 0.1), []float64{0, 0.1, 0.2, 0.3})
+	assertEqual(t, slice.Max(slice.Range(0, 0.7, 0.1)), 0.7)
+	assertEqual(t, len(slice.Range(0, 0.7, 0.1)), 8)
+	assertEqual(t, slice.Min(slice.Range(1, 0, -0.1)), 0.0)
+	assertEqual(t, len(slice.Range(1, 0, -0.1)), 11)
+	assertEqual(t, slice.Range(0, 0.35, 0.1), []float64{0, 0.1, 0.2, 0.30000000000000004})
+
+	assertPanics(t, func() { slice.Range(1, 5, 0) }, "unexpected value for step parameter")
+	assertPanics(t, func() { slice.Range(0, 1, math.NaN()) }, "unexpected value for step parameter")
+	assertPanics(t, func() { slice.Range(0, 1, math.Inf(1)) }, "unexpected value for step parameter")
+	assertPanics(t, func() { slice.Range(math.Inf(-1), 1, 1) }, "unexpected value for start parameter")
+	assertPanics(t, func() { slice.Range(0, math.Inf(1), 1) }, "unexpected value for stop parameter")
+	assertPanics(t, func() { slice.Range(0, math.NaN(), 1) }, "unexpected value for stop parameter")
+}
+
 func TestReduce(t *testing.T) {
 	planets := []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
 	got := slice.Reduce(planets, func(planet string, acc string) string {
@@ -233,10 +781,49 @@ func TestReject(t *testing.T) {
 	assertEqual(t, got, []int{1, 3, 5, 7, 9})
 }
 
+func TestRejectInPlace(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	got := slice.RejectInPlace(numbers, func(number int) bool {
+		return number%2 == 0
+	})
+	assertEqual(t, got, []int{1, 3, 5, 7, 9})
+	assertEqual(t, numbers[5:], []int{0, 0, 0, 0})
+}
+
+func TestRepeatedly(t *testing.T) {
+	calls := 0
+	got := slice.Repeatedly(3, func() int {
+		calls++
+		return calls
+	})
+	assertEqual(t, got, []int{1, 2, 3})
+	assertEqual(t, slice.Repeatedly(0, func() int { return 1 }), []int{})
+}
+
 func TestReverse(t *testing.T) {
 	planets := []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
 	expected := []string{"Neptune", "Uranus", "Saturn", "Jupiter", "Mars", "Earth", "Venus", "Mercury"}
 	assertEqual(t, slice.Reverse(planets), expected)
+	assertEqual(t, slice.Reverse([][]int{{1}, {2, 3}}), [][]int{{2, 3}, {1}})
+	assertEqual(t, slice.Reverse([]int{}), []int{})
+}
+
+func TestRunningMax(t *testing.T) {
+	assertEqual(t, slice.RunningMax([]int{3, 1, 4, 1, 5, 9, 2, 6}), []int{3, 3, 4, 4, 5, 9, 9, 9})
+	assertEqual(t, slice.RunningMax([]string{}), []string{})
+}
+
+func TestRunningMin(t *testing.T) {
+	assertEqual(t, slice.RunningMin([]int{3, 1, 4, 1, 5, 0, 2}), []int{3, 1, 1, 1, 1, 0, 0})
+	assertEqual(t, slice.RunningMin([]string{}), []string{})
+}
+
+func TestScan(t *testing.T) {
+	got := slice.Scan([]string{"a", "b", "c"}, func(letter string, accumulator string) string {
+		return accumulator + letter
+	}, "")
+	assertEqual(t, got, []string{"a", "ab", "abc"})
+	assertEqual(t, slice.Scan([]int{}, func(number int, total int) int { return number + total }, 0), []int{})
 }
 
 func TestShuffle(t *testing.T) {
@@ -244,6 +831,28 @@ func TestShuffle(t *testing.T) {
 	assertEqual(t, slice.Shuffle(planets, 42), []string{"Saturn", "Neptune", "Jupiter", "Uranus", "Venus", "Mars", "Mercury", "Earth"})
 }
 
+func TestShuffleFrom(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
+	first := slice.ShuffleFrom(planets, rand.New(rand.NewSource(42)))
+	second := slice.ShuffleFrom(planets, rand.New(rand.NewSource(42)))
+	assertEqual(t, first, second)
+	assertEqual(t, first, slice.Shuffle(planets, 42))
+	assertEqual(t, slice.ShuffleFrom(planets, slice.LockedRandomSource(rand.New(rand.NewSource(42)))), first)
+	assertEqual(t, slice.Sort(first, slice.Asc), slice.Sort(planets, slice.Asc))
+	assertEqual(t, planets, []string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"})
+}
+
+func TestSlice(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assertEqual(t, slice.Slice(numbers, 5, 100), []int{6, 7, 8, 9, 10})
+	assertEqual(t, slice.Slice(numbers, 2, 3), []int{3, 4, 5})
+	assertEqual(t, slice.Slice(numbers, -3, 2), []int{8, 9})
+	assertEqual(t, slice.Slice(numbers, 10, 5), []int{})
+	assertEqual(t, slice.Slice(numbers, -11, 5), []int{})
+	assertEqual(t, slice.Slice(numbers, 0, 0), []int{})
+	assertEqual(t, slice.Slice([]int{1, 2, 3}, 1, math.MaxUint), []int{2, 3})
+}
+
 func TestSort(t *testing.T) {
 	numbers := []int{5, 6, 1, 3, 7, 8, 2, 4, 9}
 	assertEqual(t, slice.Sort(numbers, slice.Asc), []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
@@ -264,6 +873,73 @@ func TestSortBy(t *testing.T) {
 	assertEqual(t, slice.SortBy(planets, func(planet planet) int {
 		return planet.Radius
 	}, slice.Desc), []planet{jupiter, neptune, mars})
+
+	numbers := make([]int, 100)
+	for index := range numbers {
+		numbers[index] = index
+	}
+	byParity := slice.SortBy(numbers, func(number int) int {
+		return number % 2
+	}, slice.Asc)
+	assertEqual(t, byParity[:50], slice.Filter(numbers, func(number int) bool { return number%2 == 0 }))
+	assertEqual(t, byParity[50:], slice.Filter(numbers, func(number int) bool { return number%2 == 1 }))
+
+	parity, calls := countCalls(func(number int) int {
+		return number % 2
+	})
+	slice.SortBy(numbers, parity, slice.Desc)
+	assertEqual(t, *calls, len(numbers))
+}
+
+func TestSortByKeys(t *testing.T) {
+	type player struct {
+		Team  string
+		Score int
+		Name  string
+	}
+	players := []player{
+		{Team: "red", Score: 10, Name: "Dee"},
+		{Team: "blue", Score: 7, Name: "Ann"},
+		{Team: "red", Score: 12, Name: "Bob"},
+		{Team: "blue", Score: 7, Name: "Al"},
+		{Team: "red", Score: 10, Name: "Cy"},
+	}
+	got := slice.SortByKeys(players,
+		slice.By(func(player player) string { return player.Team }, slice.Asc),
+		slice.By(func(player player) int { return player.Score }, slice.Desc),
+		slice.By(func(player player) string { return player.Name }, slice.Asc),
+	)
+	assertEqual(t, got, []player{
+		{Team: "blue", Score: 7, Name: "Al"},
+		{Team: "blue", Score: 7, Name: "Ann"},
+		{Team: "red", Score: 12, Name: "Bob"},
+		{Team: "red", Score: 10, Name: "Cy"},
+		{Team: "red", Score: 10, Name: "Dee"},
+	})
+
+	byTeam := slice.SortByKeys(players, slice.By(func(player player) string { return player.Team }, slice.Asc))
+	assertEqual(t, slice.Map(byTeam, func(player player) string { return player.Name }), []string{"Ann", "Al", "Dee", "Bob", "Cy"})
+	assertEqual(t, slice.SortByKeys(players), players)
+
+	team, teamCalls := countCalls(func(player player) string {
+		return player.Team
+	})
+	score, scoreCalls := countCalls(func(player player) int {
+		return player.Score
+	})
+	slice.SortByKeys(players, slice.By(team, slice.Asc), slice.By(score, slice.Desc))
+	assertEqual(t, *teamCalls, len(players))
+	assertEqual(t, *scoreCalls, len(players))
+}
+
+func TestSortWith(t *testing.T) {
+	launches := []time.Time{time.Date(1977, 9, 5, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 19, 0, 0, 0, 0, time.UTC), time.Date(1977, 8, 20, 0, 0, 0, 0, time.UTC)}
+	assertEqual(t, slice.SortWith(launches, compareTimes), []time.Time{launches[2], launches[0], launches[1]})
+
+	byLength := slice.SortWith([]string{"ccc", "a", "bb", "b", "aa"}, func(left string, right string) int {
+		return len(left) - len(right)
+	})
+	assertEqual(t, byLength, []string{"a", "b", "bb", "aa", "ccc"})
 }
 
 func TestSplitWhile(t *testing.T) {
@@ -287,6 +963,7 @@ func TestSplitWith(t *testing.T) {
 func TestSum(t *testing.T) {
 	numbers := []int{6, 4, 8, 2, 1, 9, 4, 7, 5}
 	assertEqual(t, slice.Sum(numbers), 46)
+	assertEqual(t, slice.Sum([]float64{}), 0.0)
 }
 
 func TestSumBy(t *testing.T) {
@@ -306,6 +983,22 @@ func TestTake(t *testing.T) {
 	assertEqual(t, slice.Take(planets, 2), []string{"Mercury", "Venus"})
 	assertEqual(t, slice.Take(planets, 10), planets)
 	assertEqual(t, slice.Take(planets, 0), []string{})
+	assertEqual(t, slice.Take(planets, math.MaxUint), planets)
+}
+
+func TestTakeEvery(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assertEqual(t, slice.TakeEvery(numbers, 2), []int{1, 3, 5, 7, 9})
+	assertEqual(t, slice.TakeEvery(numbers, 3), []int{1, 4, 7, 10})
+	assertEqual(t, slice.TakeEvery(numbers, 1), numbers)
+	assertEqual(t, slice.TakeEvery(numbers, 0), []int{})
+}
+
+func TestTakeLast(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars"}
+	assertEqual(t, slice.TakeLast(planets, 2), []string{"Earth", "Mars"})
+	assertEqual(t, slice.TakeLast(planets, 10), planets)
+	assertEqual(t, slice.TakeLast(planets, 0), []string{})
 }
 
 func TestTakeWhile(t *testing.T) {
@@ -315,9 +1008,17 @@ func TestTakeWhile(t *testing.T) {
 	}), []int{1, 2, 3, 4, 5})
 }
 
+func TestUnfold(t *testing.T) {
+	countdown := slice.Unfold(3, func(number int) (string, int, bool) {
+		return strconv.Itoa(number), number - 1, number > 0
+	})
+	assertEqual(t, countdown, []string{"3", "2", "1"})
+}
+
 func TestUniq(t *testing.T) {
 	moves := []string{"Up", "Down", "Up", "Up", "Down", "Left", "Right", "Right", "Right", "Left"}
 	assertEqual(t, slice.Uniq(moves), []string{"Up", "Down", "Left", "Right"})
+	assertEqual(t, slice.Uniq([]int{}), []int{})
 }
 
 func TestUniqBy(t *testing.T) {
@@ -331,6 +1032,124 @@ func TestUniqBy(t *testing.T) {
 	}), []planet{mars, neptune})
 }
 
+func TestUniqWith(t *testing.T) {
+	utc := time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC)
+	sameInstant := utc.In(time.FixedZone("CET", 3600))
+	later := utc.Add(time.Hour)
+	assertEqual(t, slice.UniqWith([]time.Time{utc, sameInstant, later, utc}, compareTimes), []time.Time{utc, later})
+}
+
+func TestUnzip(t *testing.T) {
+	names, moons := slice.Unzip([]slice.Pair[string, int]{{First: "Earth", Second: 1}, {First: "Mars", Second: 2}})
+	assertEqual(t, names, []string{"Earth", "Mars"})
+	assertEqual(t, moons, []int{1, 2})
+}
+
+func TestUnzip3(t *testing.T) {
+	numbers, letters, flags := slice.Unzip3(slice.Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true, false}))
+	assertEqual(t, numbers, []int{1, 2})
+	assertEqual(t, letters, []string{"a", "b"})
+	assertEqual(t, flags, []bool{true, false})
+}
+
+func TestZip(t *testing.T) {
+	names := []string{"Mercury", "Venus", "Earth"}
+	moons := []int{0, 0, 1, 2}
+	assertEqual(t, slice.Zip(names, moons), []slice.Pair[string, int]{
+		{First: "Mercury", Second: 0},
+		{First: "Venus", Second: 0},
+		{First: "Earth", Second: 1},
+	})
+	assertEqual(t, slice.Zip(names, []int{}), []slice.Pair[string, int]{})
+}
+
+func TestZip3(t *testing.T) {
+	got := slice.Zip3([]int{1, 2, 3}, []string{"a", "b"}, []bool{true, false, true})
+	assertEqual(t, got, []slice.Triple[int, string, bool]{
+		{First: 1, Second: "a", Third: true},
+		{First: 2, Second: "b", Third: false},
+	})
+}
+
+func TestZipLongest(t *testing.T) {
+	got := slice.ZipLongest([]string{"Mercury", "Venus", "Earth"}, []int{0, 0}, "", -1)
+	assertEqual(t, got, []slice.Pair[string, int]{
+		{First: "Mercury", Second: 0},
+		{First: "Venus", Second: 0},
+		{First: "Earth", Second: -1},
+	})
+	got = slice.ZipLongest([]string{}, []int{4}, "?", -1)
+	assertEqual(t, got, []slice.Pair[string, int]{{First: "?", Second: 4}})
+}
+
+func TestZipReduce(t *testing.T) {
+	got := slice.ZipReduce([]int{1, 2, 3}, []int{4, 5}, func(left int, right int, total int) int {
+		return total + left*right
+	}, 0)
+	assertEqual(t, got, 14)
+}
+
+func TestZipWith(t *testing.T) {
+	got := slice.ZipWith([]string{"a", "b", "c"}, []int{1, 2}, func(letter string, count int) string {
+		return strings.Repeat(letter, count)
+	})
+	assertEqual(t, got, []string{"a", "bb"})
+}
+
+func BenchmarkReverse(b *testing.B) {
+	numbers := make([]int, 10_000)
+	for index := range numbers {
+		numbers[index] = index
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		slice.Reverse(numbers)
+	}
+}
+
+func BenchmarkUniq(b *testing.B) {
+	numbers := make([]int, 10_000)
+	for index := range numbers {
+		numbers[index] = index % 5_000
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		slice.Uniq(numbers)
+	}
+}
+
+func BenchmarkUniqBy(b *testing.B) {
+	numbers := make([]int, 10_000)
+	for index := range numbers {
+		numbers[index] = index
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		slice.UniqBy(numbers, func(number int) int {
+			return number % 5_000
+		})
+	}
+}
+
+// countCalls wraps fun and counts how many times it is invoked.
+func countCalls[Element any, Key any](fun func(Element) Key) (func(Element) Key, *int) {
+	calls := 0
+	return func(element Element) Key {
+		calls++
+		return fun(element)
+	}, &calls
+}
+
+func compareTimes(left time.Time, right time.Time) int {
+	if left.Before(right) {
+		return -1
+	}
+	if left.After(right) {
+		return 1
+	}
+	return 0
+}
+
 func assertEqual[T any](t *testing.T, got T, expected T) {
 	if !reflect.DeepEqual(got, expected) {
 		t.Errorf("\n     got: %v\nexpected: %v\n", got, expected)
@@ -342,3 +1161,12 @@ func assertNotEqual[T any](t *testing.T, got T, expected T) {
 		t.Errorf("\n     got: %v\nexpected: %v\n", got, expected)
 	}
 }
+
+func assertPanics(t *testing.T, fun func(), expected interface{}) {
+	defer func() {
+		if got := recover(); !reflect.DeepEqual(got, expected) {
+			t.Errorf("\n     got panic: %v\nexpected panic: %v\n", got, expected)
+		}
+	}()
+	fun()
+}
@@ -0,0 +1,187 @@
+// Package stats implements descriptive statistics over slices of numbers using generics.
+//
+// Every function has a By variant which computes the statistic over the values returned by a key function,
+// as slice.SumBy does. Functions returning a float64 return NaN for an empty slice.
+package stats
+
+import (
+	"math"
+
+	"github.com/nwjlyons/slice"
+)
+
+// Interpolation selects how Quantile and Percentile pick a value when the quantile falls between two elements.
+type Interpolation int
+
+const (
+	// Linear interpolates linearly between the two closest elements.
+	Linear Interpolation = iota
+	// Lower picks the lower of the two closest elements.
+	Lower
+	// Higher picks the higher of the two closest elements.
+	Higher
+	// Nearest picks the closest element, rounding half way positions to the even index.
+	Nearest
+	// Midpoint averages the two closest elements.
+	Midpoint
+)
+
+// Description summarises a slice of numbers, see Describe.
+type Description[Element slice.Number] struct {
+	Count  int
+	Min    Element
+	Max    Element
+	Mean   float64
+	StdDev float64
+}
+
+// Describe returns the count, minimum, maximum, mean and population standard deviation of the elements
+// in a single pass. For an empty slice Min and Max are zero and Mean and StdDev are NaN.
+func Describe[Element slice.Number](elements []Element) Description[Element] {
+	return DescribeBy(elements, identity[Element])
+}
+
+// DescribeBy returns the count, minimum, maximum, mean and population standard deviation of the values
+// returned by fun in a single pass. For an empty slice Min and Max are zero and Mean and StdDev are NaN.
+func DescribeBy[Element any, Value slice.Number](elements []Element, fun func(Element) Value) Description[Value] {
+	if len(elements) == 0 {
+		return Description[Value]{Mean: math.NaN(), StdDev: math.NaN()}
+	}
+	first := fun(elements[0])
+	description := Description[Value]{Count: 1, Min: first, Max: first, Mean: float64(first)}
+	// Welford's algorithm, squares is the running sum of squared differences from the mean.
+	squares := 0.0
+	slice.Each(elements[1:], func(element Element) {
+		value := fun(element)
+		description.Count++
+		if value < description.Min {
+			description.Min = value
+		}
+		if value > description.Max {
+			description.Max = value
+		}
+		delta := float64(value) - description.Mean
+		description.Mean += delta / float64(description.Count)
+		squares += delta * (float64(value) - description.Mean)
+	})
+	description.StdDev = math.Sqrt(squares / float64(description.Count))
+	return description
+}
+
+// Mean returns the arithmetic mean of the elements.
+func Mean[Element slice.Number](elements []Element) float64 {
+	return MeanBy(elements, identity[Element])
+}
+
+// MeanBy returns the arithmetic mean of the values returned by fun.
+func MeanBy[Element any, Value slice.Number](elements []Element, fun func(Element) Value) float64 {
+	if len(elements) == 0 {
+		return math.NaN()
+	}
+	return slice.SumBy(elements, func(element Element) float64 {
+		return float64(fun(element))
+	}) / float64(len(elements))
+}
+
+// Median returns the middle element, or the mean of the two middle elements for an even number of elements.
+func Median[Element slice.Number](elements []Element) float64 {
+	return MedianBy(elements, identity[Element])
+}
+
+// MedianBy returns the median of the values returned by fun.
+func MedianBy[Element any, Value slice.Number](elements []Element, fun func(Element) Value) float64 {
+	return QuantileBy(elements, fun, 0.5, Midpoint)
+}
+
+// Mode returns the most frequent elements in the order they first appear. It returns more than one element
+// when several are equally frequent, and an empty slice for an empty slice.
+func Mode[Element comparable](elements []Element) []Element {
+	return ModeBy(elements, identity[Element])
+}
+
+// ModeBy returns the most frequent values returned by fun in the order they first appear.
+func ModeBy[Element any, Value comparable](elements []Element, fun func(Element) Value) []Value {
+	values := slice.Map(elements, fun)
+	frequencies := slice.Frequencies(values)
+	highest := slice.MaxOr(slice.Map(values, func(value Value) int {
+		return frequencies[value]
+	}), 0)
+	return slice.Filter(slice.Uniq(values), func(value Value) bool {
+		return frequencies[value] == highest
+	})
+}
+
+// Percentile returns the pth percentile of the elements, where p is between 0 and 100, see Quantile.
+func Percentile[Element slice.Number](elements []Element, p float64, method Interpolation) float64 {
+	return PercentileBy(elements, identity[Element], p, method)
+}
+
+// PercentileBy returns the pth percentile of the values returned by fun, where p is between 0 and 100.
+func PercentileBy[Element any, Value slice.Number](elements []Element, fun func(Element) Value, p float64, method Interpolation) float64 {
+	if p < 0 || p > 100 {
+		panic("unexpected value for p parameter")
+	}
+	return QuantileBy(elements, fun, p/100, method)
+}
+
+// Quantile returns the qth quantile of the elements, where q is between 0 and 1. When the quantile falls
+// between two elements method selects how the result is interpolated. It panics if q is out of range.
+func Quantile[Element slice.Number](elements []Element, q float64, method Interpolation) float64 {
+	return QuantileBy(elements, identity[Element], q, method)
+}
+
+// QuantileBy returns the qth quantile of the values returned by fun, where q is between 0 and 1.
+func QuantileBy[Element any, Value slice.Number](elements []Element, fun func(Element) Value, q float64, method Interpolation) float64 {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		panic("unexpected value for q parameter")
+	}
+	if len(elements) == 0 {
+		return math.NaN()
+	}
+	values := slice.Sort(slice.Map(elements, func(element Element) float64 {
+		return float64(fun(element))
+	}), slice.Asc)
+	position := q * float64(len(values)-1)
+	lower, higher := values[int(math.Floor(position))], values[int(math.Ceil(position))]
+	switch method {
+	case Lower:
+		return lower
+	case Higher:
+		return higher
+	case Nearest:
+		return values[int(math.RoundToEven(position))]
+	case Midpoint:
+		return (lower + higher) / 2
+	default:
+		return lower + (position-math.Floor(position))*(higher-lower)
+	}
+}
+
+// StdDev returns the population standard deviation of the elements.
+func StdDev[Element slice.Number](elements []Element) float64 {
+	return StdDevBy(elements, identity[Element])
+}
+
+// StdDevBy returns the population standard deviation of the values returned by fun.
+func StdDevBy[Element any, Value slice.Number](elements []Element, fun func(Element) Value) float64 {
+	return math.Sqrt(VarianceBy(elements, fun))
+}
+
+// Variance returns the population variance of the elements.
+func Variance[Element slice.Number](elements []Element) float64 {
+	return VarianceBy(elements, identity[Element])
+}
+
+// VarianceBy returns the population variance of the values returned by fun. fun is invoked exactly once per element.
+func VarianceBy[Element any, Value slice.Number](elements []Element, fun func(Element) Value) float64 {
+	values := slice.Map(elements, fun)
+	mean := Mean(values)
+	return MeanBy(values, func(value Value) float64 {
+		deviation := float64(value) - mean
+		return deviation * deviation
+	})
+}
+
+func identity[Element any](element Element) Element {
+	return element
+}
@@ -0,0 +1,136 @@
+package stats_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/nwjlyons/slice/stats"
+)
+
+type planet struct {
+	Name   string
+	Moons  int
+	Radius float64
+}
+
+var planets = []planet{
+	{Name: "Mercury", Moons: 0, Radius: 2_439.7},
+	{Name: "Venus", Moons: 0, Radius: 6_051.8},
+	{Name: "Earth", Moons: 1, Radius: 6_371.0},
+	{Name: "Mars", Moons: 2, Radius: 3_389.5},
+}
+
+func moons(planet planet) int {
+	return planet.Moons
+}
+
+func TestDescribe(t *testing.T) {
+	description := stats.Describe([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	assertEqual(t, description.Count, 8)
+	assertEqual(t, description.Min, 2)
+	assertEqual(t, description.Max, 9)
+	assertEqual(t, description.Mean, 5.0)
+	assertEqual(t, description.StdDev, 2.0)
+
+	empty := stats.Describe([]float64{})
+	assertEqual(t, empty.Count, 0)
+	assertEqual(t, math.IsNaN(empty.Mean), true)
+	assertEqual(t, math.IsNaN(empty.StdDev), true)
+}
+
+func TestDescribeBy(t *testing.T) {
+	description := stats.DescribeBy(planets, moons)
+	assertEqual(t, description, stats.Description[int]{Count: 4, Min: 0, Max: 2, Mean: 0.75, StdDev: math.Sqrt(0.6875)})
+}
+
+func TestMean(t *testing.T) {
+	assertEqual(t, stats.Mean([]int{1, 2, 3, 4}), 2.5)
+	assertEqual(t, stats.Mean([]int8{100, 100, 100}), 100.0)
+	assertEqual(t, math.IsNaN(stats.Mean([]int{})), true)
+}
+
+func TestMeanBy(t *testing.T) {
+	assertEqual(t, stats.MeanBy(planets, moons), 0.75)
+}
+
+func TestMedian(t *testing.T) {
+	assertEqual(t, stats.Median([]int{5, 1, 3}), 3.0)
+	assertEqual(t, stats.Median([]int{4, 1, 3, 2}), 2.5)
+	assertEqual(t, math.IsNaN(stats.Median([]int{})), true)
+}
+
+func TestMedianBy(t *testing.T) {
+	assertEqual(t, stats.MedianBy(planets, moons), 0.5)
+}
+
+func TestMode(t *testing.T) {
+	assertEqual(t, stats.Mode([]int{1, 3, 2, 3, 1, 4}), []int{1, 3})
+	assertEqual(t, stats.Mode([]float64{1.5, 2.5, 2.5}), []float64{2.5})
+	assertEqual(t, stats.Mode([]int{}), []int{})
+}
+
+func TestModeBy(t *testing.T) {
+	assertEqual(t, stats.ModeBy(planets, moons), []int{0})
+}
+
+func TestPercentile(t *testing.T) {
+	numbers := []int{15, 20, 35, 40, 50}
+	assertEqual(t, stats.Percentile(numbers, 40, stats.Linear), 29.0)
+	assertEqual(t, stats.Percentile(numbers, 100, stats.Linear), 50.0)
+	assertEqual(t, stats.Percentile(numbers, 0, stats.Linear), 15.0)
+}
+
+func TestPercentileBy(t *testing.T) {
+	assertEqual(t, stats.PercentileBy(planets, moons, 75, stats.Higher), 2.0)
+}
+
+func TestQuantile(t *testing.T) {
+	numbers := []int{1, 2, 3, 4}
+	assertEqual(t, stats.Quantile(numbers, 0.5, stats.Linear), 2.5)
+	assertEqual(t, stats.Quantile(numbers, 0.5, stats.Lower), 2.0)
+	assertEqual(t, stats.Quantile(numbers, 0.5, stats.Higher), 3.0)
+	assertEqual(t, stats.Quantile(numbers, 0.5, stats.Midpoint), 2.5)
+	assertEqual(t, stats.Quantile(numbers, 0.5, stats.Nearest), 3.0)
+	assertEqual(t, stats.Quantile(numbers, 0.25, stats.Linear), 1.75)
+	assertEqual(t, stats.Quantile(numbers, 0.25, stats.Nearest), 2.0)
+	assertEqual(t, math.IsNaN(stats.Quantile([]int{}, 0.5, stats.Linear)), true)
+}
+
+func TestQuantileBy(t *testing.T) {
+	radius := func(planet planet) float64 {
+		return planet.Radius
+	}
+	assertEqual(t, stats.QuantileBy(planets, radius, 1, stats.Linear), 6_371.0)
+	assertEqual(t, stats.QuantileBy(planets, radius, 0, stats.Linear), 2_439.7)
+}
+
+func TestStdDev(t *testing.T) {
+	assertEqual(t, stats.StdDev([]int{2, 4, 4, 4, 5, 5, 7, 9}), 2.0)
+}
+
+func TestStdDevBy(t *testing.T) {
+	assertEqual(t, stats.StdDevBy(planets, moons), math.Sqrt(0.6875))
+}
+
+func TestVariance(t *testing.T) {
+	assertEqual(t, stats.Variance([]int{2, 4, 4, 4, 5, 5, 7, 9}), 4.0)
+	assertEqual(t, stats.Variance([]float64{3}), 0.0)
+}
+
+func TestVarianceBy(t *testing.T) {
+	assertEqual(t, stats.VarianceBy(planets, moons), 0.6875)
+
+	calls := 0
+	stats.StdDevBy(planets, func(planet planet) int {
+		calls++
+		return planet.Moons
+	})
+	assertEqual(t, calls, len(planets))
+}
+
+func assertEqual[T any](t *testing.T, got T, expected T) {
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("\n     got: %v\nexpected: %v\n", got, expected)
+	}
+}
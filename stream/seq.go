@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package stream
+
+import (
+	"iter"
+
+	"github.com/nwjlyons/slice"
+)
+
+// FromSeq returns a stream of the elements in the sequence.
+func FromSeq[Element any](elements iter.Seq[Element]) Stream[Element] {
+	return func(fun func(Element) slice.Reduction) slice.Reduction {
+		for element := range elements {
+			if fun(element) == slice.Halt {
+				return slice.Halt
+			}
+		}
+		return slice.Cont
+	}
+}
+
+// Seq returns a sequence of the elements in the stream, running it as the sequence is ranged over.
+func Seq[Element any](elements Stream[Element]) iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		elements(func(element Element) slice.Reduction {
+			if yield(element) {
+				return slice.Cont
+			}
+			return slice.Halt
+		})
+	}
+}
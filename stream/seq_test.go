@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package stream_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/nwjlyons/slice/stream"
+)
+
+func TestFromSeq(t *testing.T) {
+	numbers := stream.FromSeq(slices.Values([]int{1, 2, 3, 4}))
+	assertEqual(t, stream.ToSlice(stream.Take(numbers, 3)), []int{1, 2, 3})
+
+	keys := stream.FromSeq(maps.Keys(map[string]int{"a": 1}))
+	assertEqual(t, stream.ToSlice(keys), []string{"a"})
+}
+
+func TestSeq(t *testing.T) {
+	numbers := stream.Map(stream.FromSlice([]int{1, 2, 3, 4}), func(number int) int {
+		return number * number
+	})
+	got := make([]int, 0)
+	for number := range stream.Seq(numbers) {
+		if number > 4 {
+			break
+		}
+		got = append(got, number)
+	}
+	assertEqual(t, got, []int{1, 4})
+}
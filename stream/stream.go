@@ -0,0 +1,201 @@
+// Package stream implements Elixir's Stream module in Go using generics.
+//
+// A Stream is a lazy enumerable: composing streams with Map, Filter and friends
+// does no work, elements are only produced when a terminal function such as
+// Reduce, ReduceWhile or ToSlice runs the stream.
+package stream
+
+import (
+	"github.com/nwjlyons/slice"
+)
+
+// Stream is a lazy enumerable of elements.
+//
+// Running a stream invokes fun on each element in turn. When fun returns
+// slice.Halt the stream stops producing elements and returns slice.Halt,
+// otherwise it returns slice.Cont once it is exhausted.
+type Stream[Element any] func(fun func(Element) slice.Reduction) slice.Reduction
+
+// FromSlice returns a stream of the elements in the slice.
+func FromSlice[Element any](elements []Element) Stream[Element] {
+	return func(fun func(Element) slice.Reduction) slice.Reduction {
+		for _, element := range elements {
+			if fun(element) == slice.Halt {
+				return slice.Halt
+			}
+		}
+		return slice.Cont
+	}
+}
+
+// Cycle returns an infinite stream repeating the elements in the slice. It is empty if the slice is empty.
+func Cycle[Element any](elements []Element) Stream[Element] {
+	return func(fun func(Element) slice.Reduction) slice.Reduction {
+		if len(elements) == 0 {
+			return slice.Cont
+		}
+		for {
+			if FromSlice(elements)(fun) == slice.Halt {
+				return slice.Halt
+			}
+		}
+	}
+}
+
+// Filter lazily returns elements where fun returns true.
+func Filter[Element any](elements Stream[Element], fun func(Element) bool) Stream[Element] {
+	return func(next func(Element) slice.Reduction) slice.Reduction {
+		return elements(func(element Element) slice.Reduction {
+			if fun(element) {
+				return next(element)
+			}
+			return slice.Cont
+		})
+	}
+}
+
+// FlatMap lazily maps the given fun over the stream and flattens the result.
+func FlatMap[Element any, ReturnElement any](elements Stream[Element], fun func(Element) Stream[ReturnElement]) Stream[ReturnElement] {
+	return func(next func(ReturnElement) slice.Reduction) slice.Reduction {
+		return elements(func(element Element) slice.Reduction {
+			return fun(element)(next)
+		})
+	}
+}
+
+// Iterate returns an infinite stream starting with start, invoking fun on the previous element to produce each one after it.
+func Iterate[Element any](start Element, fun func(Element) Element) Stream[Element] {
+	return func(next func(Element) slice.Reduction) slice.Reduction {
+		for element := start; ; element = fun(element) {
+			if next(element) == slice.Halt {
+				return slice.Halt
+			}
+		}
+	}
+}
+
+// Map lazily invokes fun on each element in the stream.
+func Map[Element any, ReturnElement any](elements Stream[Element], fun func(Element) ReturnElement) Stream[ReturnElement] {
+	return func(next func(ReturnElement) slice.Reduction) slice.Reduction {
+		return elements(func(element Element) slice.Reduction {
+			return next(fun(element))
+		})
+	}
+}
+
+// Reduce runs the stream, invoking fun on each element with the accumulator.
+func Reduce[Element any, Accumulator any](elements Stream[Element], fun func(Element, Accumulator) Accumulator, accumulator Accumulator) Accumulator {
+	return ReduceWhile(elements, func(element Element, accumulator Accumulator) (slice.Reduction, Accumulator) {
+		return slice.Cont, fun(element, accumulator)
+	}, accumulator)
+}
+
+// ReduceWhile runs the stream, invoking fun on each element with the accumulator until Halt is returned.
+func ReduceWhile[Element any, Accumulator any](elements Stream[Element], fun func(Element, Accumulator) (slice.Reduction, Accumulator), accumulator Accumulator) Accumulator {
+	elements(func(element Element) slice.Reduction {
+		var reduction slice.Reduction
+		reduction, accumulator = fun(element, accumulator)
+		return reduction
+	})
+	return accumulator
+}
+
+// Reject lazily returns elements excluding those where fun returns true.
+func Reject[Element any](elements Stream[Element], fun func(Element) bool) Stream[Element] {
+	return Filter(elements, func(element Element) bool {
+		return !fun(element)
+	})
+}
+
+// Repeatedly returns an infinite stream of the results of invoking fun.
+func Repeatedly[Element any](fun func() Element) Stream[Element] {
+	return func(next func(Element) slice.Reduction) slice.Reduction {
+		for {
+			if next(fun()) == slice.Halt {
+				return slice.Halt
+			}
+		}
+	}
+}
+
+// Take lazily takes an amount of elements from the beginning of the stream.
+func Take[Element any](elements Stream[Element], amount uint) Stream[Element] {
+	return func(next func(Element) slice.Reduction) slice.Reduction {
+		if amount == 0 {
+			return slice.Cont
+		}
+		taken := uint(0)
+		reduction := slice.Cont
+		elements(func(element Element) slice.Reduction {
+			taken++
+			reduction = next(element)
+			if reduction == slice.Halt || taken == amount {
+				return slice.Halt
+			}
+			return slice.Cont
+		})
+		return reduction
+	}
+}
+
+// TakeWhile lazily takes the elements from the beginning of the stream while fun returns a truthy value.
+func TakeWhile[Element any](elements Stream[Element], fun func(Element) bool) Stream[Element] {
+	return func(next func(Element) slice.Reduction) slice.Reduction {
+		reduction := slice.Cont
+		elements(func(element Element) slice.Reduction {
+			if !fun(element) {
+				return slice.Halt
+			}
+			reduction = next(element)
+			return reduction
+		})
+		return reduction
+	}
+}
+
+// ToSlice runs the stream and collects its elements into a slice.
+func ToSlice[Element any](elements Stream[Element]) []Element {
+	return Reduce(elements, func(element Element, accumulator []Element) []Element {
+		return append(accumulator, element)
+	}, make([]Element, 0))
+}
+
+// Unfold returns a stream built by invoking fun on the accumulator, which returns the next element,
+// the next accumulator and true, or false once there are no more elements.
+func Unfold[Element any, Accumulator any](accumulator Accumulator, fun func(Accumulator) (Element, Accumulator, bool)) Stream[Element] {
+	return func(next func(Element) slice.Reduction) slice.Reduction {
+		state := accumulator
+		for {
+			element, following, ok := fun(state)
+			if !ok {
+				return slice.Cont
+			}
+			if next(element) == slice.Halt {
+				return slice.Halt
+			}
+			state = following
+		}
+	}
+}
+
+// Uniq lazily removes all duplicated elements from the stream.
+func Uniq[Element comparable](elements Stream[Element]) Stream[Element] {
+	return UniqBy(elements, func(element Element) Element {
+		return element
+	})
+}
+
+// UniqBy lazily removes all duplicated elements from the stream according to fun.
+func UniqBy[Element any, UniqBy comparable](elements Stream[Element], fun func(Element) UniqBy) Stream[Element] {
+	return func(next func(Element) slice.Reduction) slice.Reduction {
+		seen := make(map[UniqBy]struct{})
+		return elements(func(element Element) slice.Reduction {
+			key := fun(element)
+			if _, ok := seen[key]; ok {
+				return slice.Cont
+			}
+			seen[key] = struct{}{}
+			return next(element)
+		})
+	}
+}
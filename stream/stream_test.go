@@ -0,0 +1,166 @@
+package stream_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+	"github.com/nwjlyons/slice/stream"
+)
+
+func TestFromSlice(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth"}
+	assertEqual(t, stream.ToSlice(stream.FromSlice(planets)), planets)
+	assertEqual(t, stream.ToSlice(stream.FromSlice([]string{})), []string{})
+}
+
+func TestCycle(t *testing.T) {
+	got := stream.ToSlice(stream.Take(stream.Cycle([]int{1, 2, 3}), 7))
+	assertEqual(t, got, []int{1, 2, 3, 1, 2, 3, 1})
+	assertEqual(t, stream.ToSlice(stream.Take(stream.Cycle([]int{}), 3)), []int{})
+}
+
+func TestFilter(t *testing.T) {
+	numbers := stream.FromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	got := stream.Filter(numbers, func(number int) bool {
+		return number%2 == 0
+	})
+	assertEqual(t, stream.ToSlice(got), []int{2, 4, 6, 8})
+}
+
+func TestFlatMap(t *testing.T) {
+	numbers := stream.FromSlice([]int{1, 2, 3})
+	got := stream.FlatMap(numbers, func(number int) stream.Stream[string] {
+		return stream.FromSlice([]string{strconv.Itoa(number), strconv.Itoa(number)})
+	})
+	assertEqual(t, stream.ToSlice(got), []string{"1", "1", "2", "2", "3", "3"})
+
+	taken := stream.Take(stream.FlatMap(numbers, func(number int) stream.Stream[int] {
+		return stream.Take(stream.FromSlice([]int{number, number, number}), 2)
+	}), 5)
+	assertEqual(t, stream.ToSlice(taken), []int{1, 1, 2, 2, 3})
+}
+
+func TestIterate(t *testing.T) {
+	double := func(number int) int {
+		return number * 2
+	}
+	assertEqual(t, stream.ToSlice(stream.Take(stream.Iterate(1, double), 5)), []int{1, 2, 4, 8, 16})
+	below := stream.TakeWhile(stream.Iterate(1, double), func(number int) bool {
+		return number < 100
+	})
+	assertEqual(t, stream.ToSlice(below), []int{1, 2, 4, 8, 16, 32, 64})
+}
+
+func TestMap(t *testing.T) {
+	trafficLights := stream.FromSlice([]string{"red", "amber", "green"})
+	got := stream.Map(trafficLights, func(light string) string {
+		return light + "!"
+	})
+	assertEqual(t, stream.ToSlice(got), []string{"red!", "amber!", "green!"})
+}
+
+func TestReduce(t *testing.T) {
+	planets := stream.FromSlice([]string{"Mercury", "Venus", "Earth"})
+	got := stream.Reduce(planets, func(planet string, acc string) string {
+		return acc + planet
+	}, "")
+	assertEqual(t, got, "MercuryVenusEarth")
+}
+
+func TestReduceWhile(t *testing.T) {
+	visited := 0
+	numbers := stream.Map(stream.FromSlice([]int{40, 2, 8, 16}), func(number int) int {
+		visited++
+		return number
+	})
+	got := stream.ReduceWhile(numbers, func(number int, total int) (slice.Reduction, int) {
+		if total+number > 42 {
+			return slice.Halt, total
+		}
+		return slice.Cont, number + total
+	}, 0)
+	assertEqual(t, got, 42)
+	assertEqual(t, visited, 3)
+}
+
+func TestReject(t *testing.T) {
+	numbers := stream.FromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	got := stream.Reject(numbers, func(number int) bool {
+		return number%2 == 0
+	})
+	assertEqual(t, stream.ToSlice(got), []int{1, 3, 5, 7, 9})
+}
+
+func TestRepeatedly(t *testing.T) {
+	calls := 0
+	counter := stream.Repeatedly(func() int {
+		calls++
+		return calls
+	})
+	assertEqual(t, stream.ToSlice(stream.Take(counter, 3)), []int{1, 2, 3})
+	assertEqual(t, calls, 3)
+}
+
+func TestTake(t *testing.T) {
+	planets := stream.FromSlice([]string{"Mercury", "Venus", "Earth", "Mars"})
+	assertEqual(t, stream.ToSlice(stream.Take(planets, 2)), []string{"Mercury", "Venus"})
+	assertEqual(t, stream.ToSlice(stream.Take(planets, 10)), []string{"Mercury", "Venus", "Earth", "Mars"})
+	assertEqual(t, stream.ToSlice(stream.Take(planets, 0)), []string{})
+
+	visited := 0
+	numbers := stream.Map(stream.FromSlice(make([]int, 1_000)), func(number int) int {
+		visited++
+		return number
+	})
+	stream.ToSlice(stream.Take(numbers, 3))
+	assertEqual(t, visited, 3)
+}
+
+func TestTakeWhile(t *testing.T) {
+	numbers := stream.FromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	assertEqual(t, stream.ToSlice(stream.TakeWhile(numbers, func(number int) bool {
+		return number <= 5
+	})), []int{1, 2, 3, 4, 5})
+}
+
+func TestToSlice(t *testing.T) {
+	numbers := stream.Take(stream.FromSlice([]int{1, 2, 3}), 2)
+	assertEqual(t, stream.ToSlice(numbers), []int{1, 2})
+	assertEqual(t, stream.ToSlice(numbers), []int{1, 2})
+}
+
+func TestUnfold(t *testing.T) {
+	fibonacci := stream.Unfold([2]int{0, 1}, func(pair [2]int) (int, [2]int, bool) {
+		return pair[0], [2]int{pair[1], pair[0] + pair[1]}, true
+	})
+	assertEqual(t, stream.ToSlice(stream.Take(fibonacci, 8)), []int{0, 1, 1, 2, 3, 5, 8, 13})
+	assertEqual(t, stream.ToSlice(stream.Take(fibonacci, 3)), []int{0, 1, 1})
+
+	countdown := stream.Unfold(3, func(number int) (int, int, bool) {
+		return number, number - 1, number > 0
+	})
+	assertEqual(t, stream.ToSlice(countdown), []int{3, 2, 1})
+}
+
+func TestUniq(t *testing.T) {
+	moves := stream.FromSlice([]string{"Up", "Down", "Up", "Up", "Down", "Left", "Right", "Right", "Left"})
+	uniq := stream.Uniq(moves)
+	assertEqual(t, stream.ToSlice(uniq), []string{"Up", "Down", "Left", "Right"})
+	assertEqual(t, stream.ToSlice(uniq), []string{"Up", "Down", "Left", "Right"})
+}
+
+func TestUniqBy(t *testing.T) {
+	words := stream.FromSlice([]string{"a", "bb", "c", "dd", "eee"})
+	got := stream.UniqBy(words, func(word string) int {
+		return len(word)
+	})
+	assertEqual(t, stream.ToSlice(got), []string{"a", "bb", "eee"})
+}
+
+func assertEqual[T any](t *testing.T, got T, expected T) {
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("\n     got: %v\nexpected: %v\n", got, expected)
+	}
+}
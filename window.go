@@ -0,0 +1,161 @@
+package slice
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// MovingAverage returns the mean of every window of size consecutive elements.
+func MovingAverage[Element Number](elements []Element, size uint) []float64 {
+	return Map(MovingSum(elements, size), func(sum Element) float64 {
+		return float64(sum) / float64(size)
+	})
+}
+
+// MovingMax returns the maximum of every window of size consecutive elements in O(n).
+func MovingMax[Element constraints.Ordered](elements []Element, size uint) []Element {
+	return movingExtreme(elements, size, func(left Element, right Element) bool {
+		return left >= right
+	})
+}
+
+// MovingMin returns the minimum of every window of size consecutive elements in O(n).
+func MovingMin[Element constraints.Ordered](elements []Element, size uint) []Element {
+	return movingExtreme(elements, size, func(left Element, right Element) bool {
+		return left <= right
+	})
+}
+
+// MovingSum returns the sum of every window of size consecutive elements in O(n).
+//
+// Float sums are compensated, so a large element leaving the window does not take the precision of the
+// smaller ones with it, and a window holding an infinity or NaN is summed directly.
+func MovingSum[Element Number](elements []Element, size uint) []Element {
+	if !isInteger[Element]() {
+		return movingSumFloat(elements, size)
+	}
+	return WindowReduce(elements, size, func(element Element, sum Element) Element {
+		return sum + element
+	}, func(element Element, sum Element) Element {
+		return sum - element
+	}, 0)
+}
+
+// WindowReduce slides a window of size elements over the slice and returns the accumulator for every window.
+//
+// Rather than reducing each window from scratch, add is invoked once for the element entering the window
+// and remove once for the element leaving it, so the whole slice is reduced in O(n). It panics if size is zero.
+func WindowReduce[Element any, Accumulator any](elements []Element, size uint, add func(Element, Accumulator) Accumulator, remove func(Element, Accumulator) Accumulator, accumulator Accumulator) []Accumulator {
+	if size == 0 {
+		panic("unexpected value for size parameter")
+	}
+	window := int(size)
+	if len(elements) < window {
+		return make([]Accumulator, 0)
+	}
+	reduced := make([]Accumulator, 0, len(elements)-window+1)
+	for index, element := range elements {
+		if index >= window {
+			accumulator = remove(elements[index-window], accumulator)
+		}
+		accumulator = add(element, accumulator)
+		if index >= window-1 {
+			reduced = append(reduced, accumulator)
+		}
+	}
+	return reduced
+}
+
+// Windows returns every window of size consecutive elements, where each new window starts step elements
+// after the previous one. Use a step equal to size for tumbling windows.
+//
+// The windows are views sharing memory with elements rather than copies, though appending to a window never
+// overwrites the input. Incomplete windows at the end of the slice are dropped. It panics if size or step is zero.
+func Windows[Element any](elements []Element, size uint, step uint) [][]Element {
+	if size == 0 {
+		panic("unexpected value for size parameter")
+	}
+	if step == 0 {
+		panic("unexpected value for step parameter")
+	}
+	windows := make([][]Element, 0)
+	for start := 0; start+int(size) <= len(elements); start += int(step) {
+		end := start + int(size)
+		windows = append(windows, elements[start:end:end])
+	}
+	return windows
+}
+
+// movingExtreme returns the extreme of every window of size consecutive elements, where keep reports whether
+// left should be kept over right. It keeps a monotonic queue of indices so each element is pushed and popped once.
+func movingExtreme[Element any](elements []Element, size uint, keep func(Element, Element) bool) []Element {
+	if size == 0 {
+		panic("unexpected value for size parameter")
+	}
+	window := int(size)
+	if len(elements) < window {
+		return make([]Element, 0)
+	}
+	extremes := make([]Element, 0, len(elements)-window+1)
+	queue := make([]int, 0, window)
+	for index, element := range elements {
+		if len(queue) > 0 && queue[0] <= index-window {
+			queue = queue[1:]
+		}
+		for len(queue) > 0 && !keep(elements[queue[len(queue)-1]], element) {
+			queue = queue[:len(queue)-1]
+		}
+		queue = append(queue, index)
+		if index >= window-1 {
+			extremes = append(extremes, elements[queue[0]])
+		}
+	}
+	return extremes
+}
+
+// windowSum is a running Neumaier compensated sum of the finite elements in a window, along with a count of
+// the non-finite elements, which cannot be removed from a running sum once added.
+type windowSum struct {
+	sum          float64
+	compensation float64
+	nonFinite    int
+}
+
+// add adds value to the sum, or counts it if it is not finite, using a negative sign to remove it again.
+func (w windowSum) add(value float64, sign int) windowSum {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		w.nonFinite += sign
+		return w
+	}
+	value *= float64(sign)
+	total := w.sum + value
+	if math.Abs(w.sum) >= math.Abs(value) {
+		w.compensation += (w.sum - total) + value
+	} else {
+		w.compensation += (value - total) + w.sum
+	}
+	w.sum = total
+	return w
+}
+
+// movingSumFloat implements MovingSum for floats, recomputing any window that holds a non-finite element.
+func movingSumFloat[Element Number](elements []Element, size uint) []Element {
+	sums := WindowReduce(elements, size, func(element Element, sum windowSum) windowSum {
+		return sum.add(float64(element), 1)
+	}, func(element Element, sum windowSum) windowSum {
+		return sum.add(float64(element), -1)
+	}, windowSum{})
+	return MapWithIndex(sums, func(sum windowSum, index int) Element {
+		if sum.nonFinite > 0 {
+			return Sum(elements[index : index+int(size)])
+		}
+		return Element(sum.sum + sum.compensation)
+	})
+}
+
+// isInteger reports whether Element is an integer type rather than a float.
+func isInteger[Element Number]() bool {
+	// Only integer division truncates one half to zero.
+	return Element(1)/Element(2) == 0
+}
@@ -0,0 +1,67 @@
+package slice_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+func TestMovingAverage(t *testing.T) {
+	assertEqual(t, slice.MovingAverage([]int{1, 2, 3, 4, 5, 6}, 2), []float64{1.5, 2.5, 3.5, 4.5, 5.5})
+	assertEqual(t, slice.MovingAverage([]float64{2, 4, 6}, 3), []float64{4})
+	assertEqual(t, slice.MovingAverage([]int{1, 2}, 3), []float64{})
+	assertEqual(t, slice.MovingAverage([]float64{1e16, 1, 1, 1}, 2), []float64{5e15, 1, 1})
+}
+
+func TestMovingMax(t *testing.T) {
+	numbers := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	assertEqual(t, slice.MovingMax(numbers, 3), []int{3, 3, 5, 5, 6, 7})
+	assertEqual(t, slice.MovingMax(numbers, 1), numbers)
+	assertEqual(t, slice.MovingMax([]int{2, 2, 2, 1}, 2), []int{2, 2, 2})
+}
+
+func TestMovingMin(t *testing.T) {
+	numbers := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	assertEqual(t, slice.MovingMin(numbers, 3), []int{-1, -3, -3, -3, 3, 3})
+	assertEqual(t, slice.MovingMin(numbers, 8), []int{-3})
+	assertEqual(t, slice.MovingMin(numbers, 9), []int{})
+}
+
+func TestMovingSum(t *testing.T) {
+	assertEqual(t, slice.MovingSum([]int{1, 2, 3, 4, 5}, 3), []int{6, 9, 12})
+	assertEqual(t, slice.MovingSum([]int{1, 2, 3}, 1), []int{1, 2, 3})
+	assertEqual(t, slice.MovingSum([]float64{1e16, 1, 1, 1}, 2), []float64{1e16, 2, 2})
+	assertEqual(t, slice.MovingSum([]float64{0.1, 0.2, 0.3, 0.4}, 2), []float64{0.30000000000000004, 0.5, 0.7})
+	assertEqual(t, slice.MovingSum([]float64{math.Inf(1), 1, 2, 3}, 1), []float64{math.Inf(1), 1, 2, 3})
+	assertEqual(t, slice.MovingSum([]float64{math.Inf(1), 1, 2, 3}, 2), []float64{math.Inf(1), 3, 5})
+	assertEqual(t, fmt.Sprint(slice.MovingSum([]float64{math.NaN(), 1, 2}, 2)), "[NaN 3]")
+	assertEqual(t, slice.MovingSum([]float32{1.5, 2.5, 3}, 2), []float32{4, 5.5})
+}
+
+func TestWindowReduce(t *testing.T) {
+	calls := 0
+	count := func(word string, lengths int) int {
+		calls++
+		return lengths + len(word)
+	}
+	uncount := func(word string, lengths int) int {
+		calls++
+		return lengths - len(word)
+	}
+	got := slice.WindowReduce([]string{"a", "bb", "ccc", "d"}, 2, count, uncount, 0)
+	assertEqual(t, got, []int{3, 5, 4})
+	assertEqual(t, calls, 6)
+}
+
+func TestWindows(t *testing.T) {
+	numbers := []int{1, 2, 3, 4, 5}
+	assertEqual(t, slice.Windows(numbers, 3, 1), [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}})
+	assertEqual(t, slice.Windows(numbers, 2, 2), [][]int{{1, 2}, {3, 4}})
+	assertEqual(t, slice.Windows(numbers, 6, 1), [][]int{})
+
+	windows := slice.Windows(numbers, 2, 2)
+	_ = append(windows[0], 42)
+	assertEqual(t, numbers, []int{1, 2, 3, 4, 5})
+}
@@ -0,0 +1,79 @@
+package slice
+
+// AllWithIndex returns true if fun returns true for all elements in the slice, passing each element's index to fun.
+func AllWithIndex[Element any](elements []Element, fun func(Element, int) bool) bool {
+	return ReduceWhileWithIndex(elements, func(element Element, index int, accumulator bool) (Reduction, bool) {
+		if fun(element, index) {
+			return Cont, true
+		}
+		return Halt, false
+	}, true)
+}
+
+// AnyWithIndex returns true if fun returns true for at least one element in the slice, passing each element's index to fun.
+func AnyWithIndex[Element any](elements []Element, fun func(Element, int) bool) bool {
+	return ReduceWhileWithIndex(elements, func(element Element, index int, accumulator bool) (Reduction, bool) {
+		if fun(element, index) {
+			return Halt, true
+		}
+		return Cont, false
+	}, false)
+}
+
+// EachWithIndex invokes fun on each element in the slice along with its index.
+func EachWithIndex[Element any](elements []Element, fun func(Element, int)) {
+	ReduceWithIndex(elements, func(element Element, index int, accumulator interface{}) interface{} {
+		fun(element, index)
+		return accumulator
+	}, nil)
+}
+
+// FilterWithIndex returns elements where fun returns true, passing each element's index to fun.
+func FilterWithIndex[Element any](elements []Element, fun func(Element, int) bool) []Element {
+	return ReduceWithIndex(elements, func(element Element, index int, accumulator []Element) []Element {
+		if fun(element, index) {
+			return append(accumulator, element)
+		}
+		return accumulator
+	}, make([]Element, 0))
+}
+
+// MapWithIndex invokes fun on each element in the slice along with its index.
+func MapWithIndex[Element any, ReturnElement any](elements []Element, fun func(Element, int) ReturnElement) []ReturnElement {
+	return ReduceWithIndex(elements, func(element Element, index int, accumulator []ReturnElement) []ReturnElement {
+		return append(accumulator, fun(element, index))
+	}, make([]ReturnElement, 0, len(elements)))
+}
+
+// ReduceWhileWithIndex invokes fun on each element in the slice along with its index and the accumulator until Halt is returned.
+func ReduceWhileWithIndex[Element any, Accumulator any](elements []Element, fun func(Element, int, Accumulator) (Reduction, Accumulator), accumulator Accumulator) Accumulator {
+	reduction := Cont
+	for index, element := range elements {
+		reduction, accumulator = fun(element, index, accumulator)
+		if reduction == Halt {
+			return accumulator
+		}
+	}
+	return accumulator
+}
+
+// ReduceWithIndex invokes fun on each element in the slice along with its index and the accumulator.
+func ReduceWithIndex[Element any, Accumulator any](elements []Element, fun func(Element, int, Accumulator) Accumulator, accumulator Accumulator) Accumulator {
+	return ReduceWhileWithIndex(elements, func(element Element, index int, accumulator Accumulator) (Reduction, Accumulator) {
+		return Cont, fun(element, index, accumulator)
+	}, accumulator)
+}
+
+// RejectWithIndex returns elements excluding those where fun returns true, passing each element's index to fun.
+func RejectWithIndex[Element any](elements []Element, fun func(Element, int) bool) []Element {
+	return FilterWithIndex(elements, func(element Element, index int) bool {
+		return !fun(element, index)
+	})
+}
+
+// WithIndex returns each element in the slice paired with its index, counting from offset.
+func WithIndex[Element any](elements []Element, offset int) []Pair[Element, int] {
+	return MapWithIndex(elements, func(element Element, index int) Pair[Element, int] {
+		return Pair[Element, int]{First: element, Second: index + offset}
+	})
+}
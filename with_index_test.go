@@ -0,0 +1,80 @@
+package slice_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/nwjlyons/slice"
+)
+
+func TestAllWithIndex(t *testing.T) {
+	isIndex := func(number int, index int) bool {
+		return number == index
+	}
+	assertEqual(t, slice.AllWithIndex([]int{0, 1, 2}, isIndex), true)
+	assertEqual(t, slice.AllWithIndex([]int{0, 2, 2}, isIndex), false)
+	assertEqual(t, slice.AllWithIndex([]int{}, isIndex), true)
+}
+
+func TestAnyWithIndex(t *testing.T) {
+	isIndex := func(number int, index int) bool {
+		return number == index
+	}
+	assertEqual(t, slice.AnyWithIndex([]int{3, 1, 0}, isIndex), true)
+	assertEqual(t, slice.AnyWithIndex([]int{3, 2, 1}, isIndex), false)
+}
+
+func TestEachWithIndex(t *testing.T) {
+	countdown := []string{"3", "2", "1", "Go!"}
+	ticks := make([]string, 0)
+	slice.EachWithIndex(countdown, func(tick string, index int) {
+		ticks = append(ticks, strconv.Itoa(index)+":"+tick)
+	})
+	assertEqual(t, ticks, []string{"0:3", "1:2", "2:1", "3:Go!"})
+}
+
+func TestFilterWithIndex(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars"}
+	got := slice.FilterWithIndex(planets, func(planet string, index int) bool {
+		return index%2 == 1
+	})
+	assertEqual(t, got, []string{"Venus", "Mars"})
+}
+
+func TestMapWithIndex(t *testing.T) {
+	got := slice.MapWithIndex([]string{"a", "b", "c"}, func(letter string, index int) string {
+		return letter + strconv.Itoa(index)
+	})
+	assertEqual(t, got, []string{"a0", "b1", "c2"})
+}
+
+func TestReduceWhileWithIndex(t *testing.T) {
+	got := slice.ReduceWhileWithIndex([]int{5, 5, 5, 5}, func(number int, index int, total int) (slice.Reduction, int) {
+		if index == 2 {
+			return slice.Halt, total
+		}
+		return slice.Cont, total + number
+	}, 0)
+	assertEqual(t, got, 10)
+}
+
+func TestReduceWithIndex(t *testing.T) {
+	got := slice.ReduceWithIndex([]int{1, 1, 1}, func(number int, index int, total int) int {
+		return total + number*index
+	}, 0)
+	assertEqual(t, got, 3)
+}
+
+func TestRejectWithIndex(t *testing.T) {
+	planets := []string{"Mercury", "Venus", "Earth", "Mars"}
+	got := slice.RejectWithIndex(planets, func(planet string, index int) bool {
+		return index%2 == 1
+	})
+	assertEqual(t, got, []string{"Mercury", "Earth"})
+}
+
+func TestWithIndex(t *testing.T) {
+	assertEqual(t, slice.WithIndex([]string{"a", "b"}, 0), []slice.Pair[string, int]{{First: "a", Second: 0}, {First: "b", Second: 1}})
+	assertEqual(t, slice.WithIndex([]string{"a", "b"}, 3), []slice.Pair[string, int]{{First: "a", Second: 3}, {First: "b", Second: 4}})
+	assertEqual(t, slice.WithIndex([]string{}, 0), []slice.Pair[string, int]{})
+}